@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+)
+
+// Roles a caller can have. Donors give money, organizers run campaigns, moderators review them
+// and admins (support staff) can act on any campaign. Service is for internal callers such as
+// the donation service.
+const (
+	RoleDonor     = "donor"
+	RoleOrganizer = "organizer"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+	RoleService   = "service"
+)
+
+// Caller is the authenticated user making a gRPC call
+type Caller struct {
+	UserID int32
+	Roles  []string
+}
+
+// HasRole reports whether the caller has at least one of the given roles
+func (c Caller) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		for _, val := range c.Roles {
+			if val == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// callerKey is the context key holding the authenticated Caller
+type callerKey struct{}
+
+// NewContext returns a copy of ctx carrying the authenticated caller
+func NewContext(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// FromContext returns the caller authenticated by the interceptor.
+// It returns false for anonymous calls.
+func FromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
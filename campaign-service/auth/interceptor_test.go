@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	verifier, err := NewVerifier(VerifierConfig{HMACSecret: testSecret})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	interceptor := UnaryServerInterceptor(verifier)
+	withRole := claims()
+	withRole["role"] = RoleOrganizer
+
+	cases := []struct {
+		name          string
+		authorization string
+		code          codes.Code
+		caller        *Caller
+	}{
+		{name: "anonymous", code: codes.OK},
+		{name: "valid token", authorization: "Bearer " + signHMAC(t, withRole, testSecret), code: codes.OK, caller: &Caller{UserID: 42, Roles: []string{RoleOrganizer}}},
+		{name: "not a bearer token", authorization: "Basic dXNlcjpwYXNz", code: codes.Unauthenticated},
+		{name: "empty bearer token", authorization: "Bearer ", code: codes.Unauthenticated},
+		{name: "invalid token", authorization: "Bearer " + signHMAC(t, claims(), "other-secret"), code: codes.Unauthenticated},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			ctx := context.Background()
+			if val.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", val.authorization))
+			}
+
+			var handled bool
+			var caller Caller
+			var authenticated bool
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+				caller, authenticated = FromContext(ctx)
+				return nil, nil
+			})
+
+			if got := status.Code(err); got != val.code {
+				t.Fatalf("code = %v, want %v (%v)", got, val.code, err)
+			}
+			if val.code != codes.OK {
+				if handled {
+					t.Error("handler ran for a rejected call")
+				}
+				return
+			}
+			if authenticated != (val.caller != nil) {
+				t.Fatalf("authenticated = %v, want %v", authenticated, val.caller != nil)
+			}
+			if val.caller != nil && (caller.UserID != val.caller.UserID || !slices.Equal(caller.Roles, val.caller.Roles)) {
+				t.Errorf("caller = %+v, want %+v", caller, *val.caller)
+			}
+		})
+	}
+}
+
+// The reason a token was rejected stays in the server log
+func TestUnaryServerInterceptorHidesVerifierErrors(t *testing.T) {
+	verifier, err := NewVerifier(VerifierConfig{HMACSecret: testSecret})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	expired := claims()
+	expired["exp"] = int64(1)
+	tokens := []string{signHMAC(t, expired, testSecret), signHMAC(t, claims(), "other-secret")}
+
+	for _, token := range tokens {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		_, err := UnaryServerInterceptor(verifier)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		if msg := status.Convert(err).Message(); msg != "invalid token" {
+			t.Errorf("message = %q, want %q", msg, "invalid token")
+		}
+	}
+}
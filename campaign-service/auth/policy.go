@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Everyone in a Policy lets anonymous callers through
+const Everyone = "*"
+
+// Policy maps full gRPC method names to the roles allowed to call them.
+// Methods missing from the policy are denied.
+type Policy map[string][]string
+
+// Authorize checks the caller in ctx against the roles allowed for method
+func (p Policy) Authorize(ctx context.Context, method string) error {
+	roles, ok := p[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "%v is not allowed", method)
+	}
+	for _, role := range roles {
+		if role == Everyone {
+			return nil
+		}
+	}
+
+	caller, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+	if !caller.HasRole(roles...) {
+		return status.Errorf(codes.PermissionDenied, "caller is not allowed to call %v", method)
+	}
+	return nil
+}
+
+// UnaryAuthorizationInterceptor enforces policy on unary calls. It has to run after
+// UnaryServerInterceptor so the caller is already in the context.
+func UnaryAuthorizationInterceptor(policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := policy.Authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthorizationInterceptor enforces policy on streaming calls
+func StreamAuthorizationInterceptor(policy Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := policy.Authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwksRefreshInterval limits how often an unknown kid triggers reloading the key set
+const jwksRefreshInterval = 5 * time.Minute
+
+// VerifierConfig describes how bearer tokens are checked. Either HMACSecret or JWKSSource
+// (an http(s) URL or a file path) has to be set. Issuer and Audience are optional.
+type VerifierConfig struct {
+	HMACSecret string
+	JWKSSource string
+	Issuer     string
+	Audience   string
+}
+
+// Verifier validates bearer JWTs and turns them into a Caller
+type Verifier struct {
+	config VerifierConfig
+	parser *jwt.Parser
+
+	mu       sync.RWMutex
+	keys     map[string]interface{}
+	loadedAt time.Time
+}
+
+// tokenClaims are the claims read from a token. sub holds the numeric user id.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+	Role  string   `json:"role"`
+}
+
+// NewVerifier initializes and returns a new Verifier, loading the JWKS right away when configured
+func NewVerifier(config VerifierConfig) (*Verifier, error) {
+	options := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	if config.HMACSecret != "" {
+		options = append(options, jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}))
+	} else if config.JWKSSource != "" {
+		options = append(options, jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}))
+	} else {
+		return nil, errors.New("either an HMAC secret or a JWKS source is required")
+	}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
+	v := &Verifier{config: config, parser: jwt.NewParser(options...)}
+	if config.JWKSSource != "" {
+		if err := v.reloadKeys(); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// Verify checks the signature and claims of a token and returns the caller it identifies
+func (v *Verifier) Verify(token string) (Caller, error) {
+	var claims tokenClaims
+	if _, err := v.parser.ParseWithClaims(token, &claims, v.keyFunc); err != nil {
+		return Caller{}, err
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil || userID <= 0 {
+		return Caller{}, fmt.Errorf("subject %q is not a user id", claims.Subject)
+	}
+
+	caller := Caller{UserID: int32(userID), Roles: claims.Roles}
+	if claims.Role != "" {
+		caller.Roles = append(caller.Roles, claims.Role)
+	}
+	return caller, nil
+}
+
+// keyFunc picks the key that signed the token
+func (v *Verifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	if v.config.HMACSecret != "" {
+		return []byte(v.config.HMACSecret), nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if key, ok := v.key(kid); ok {
+		return key, nil
+	}
+
+	// The key set may have been rotated since it was loaded
+	v.mu.RLock()
+	stale := time.Since(v.loadedAt) > jwksRefreshInterval
+	v.mu.RUnlock()
+	if stale {
+		if err := v.reloadKeys(); err != nil {
+			return nil, err
+		}
+		if key, ok := v.key(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (v *Verifier) key(kid string) (interface{}, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	key, ok := v.keys[kid]
+	return key, ok
+}
+
+func (v *Verifier) reloadKeys() error {
+	keys, err := loadJWKS(v.config.JWKSSource)
+	if err != nil {
+		return fmt.Errorf("loading JWKS: %w", err)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = keys
+	v.loadedAt = time.Now()
+	return nil
+}
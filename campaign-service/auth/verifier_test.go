@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-secret"
+
+// claims returns valid claims of user 42 that expire in an hour
+func claims() jwt.MapClaims {
+	return jwt.MapClaims{"sub": "42", "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+func signHMAC(t *testing.T, claims jwt.MapClaims, secret string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return token
+}
+
+func signWithKey(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return signed
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+	return key
+}
+
+func newECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating EC key: %v", err)
+	}
+	return key
+}
+
+// jwksJSON serializes the public parts of RSA and EC keys as a JSON Web Key Set
+func jwksJSON(t *testing.T, keys map[string]interface{}) []byte {
+	t.Helper()
+	encode := func(value *big.Int) string {
+		return base64.RawURLEncoding.EncodeToString(value.Bytes())
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	for kid, key := range keys {
+		switch key := key.(type) {
+		case *rsa.PrivateKey:
+			set.Keys = append(set.Keys, jwk{Kid: kid, Kty: "RSA", N: encode(key.N), E: encode(big.NewInt(int64(key.E)))})
+		case *ecdsa.PrivateKey:
+			set.Keys = append(set.Keys, jwk{Kid: kid, Kty: "EC", Crv: "P-256", X: encode(key.X), Y: encode(key.Y)})
+		default:
+			t.Fatalf("unsupported key %T", key)
+		}
+	}
+	raw, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("encoding JWKS: %v", err)
+	}
+	return raw
+}
+
+// jwksServer serves a key set that can be swapped while the test runs and counts the requests
+type jwksServer struct {
+	*httptest.Server
+	mu    sync.Mutex
+	body  []byte
+	loads int
+}
+
+func newJWKSServer(t *testing.T, body []byte) *jwksServer {
+	t.Helper()
+	s := &jwksServer{body: body}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.loads++
+		w.Write(s.body)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) serve(body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.body = body
+}
+
+func (s *jwksServer) requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loads
+}
+
+func TestNewVerifierRequiresKeys(t *testing.T) {
+	if _, err := NewVerifier(VerifierConfig{}); err == nil {
+		t.Error("NewVerifier without a secret or key set succeeded")
+	}
+	if _, err := NewVerifier(VerifierConfig{JWKSSource: filepath.Join(t.TempDir(), "missing.json")}); err == nil {
+		t.Error("NewVerifier with a missing key set file succeeded")
+	}
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	verifier, err := NewVerifier(VerifierConfig{HMACSecret: testSecret, Issuer: "accounts", Audience: "campaigns"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	with := func(changes jwt.MapClaims) jwt.MapClaims {
+		result := claims()
+		result["iss"] = "accounts"
+		result["aud"] = "campaigns"
+		for key, value := range changes {
+			if value == nil {
+				delete(result, key)
+			} else {
+				result[key] = value
+			}
+		}
+		return result
+	}
+
+	cases := []struct {
+		name  string
+		token string
+		want  Caller
+		fails bool
+	}{
+		{
+			name:  "valid",
+			token: signHMAC(t, with(nil), testSecret),
+			want:  Caller{UserID: 42},
+		},
+		{
+			name:  "roles and role are merged",
+			token: signHMAC(t, with(jwt.MapClaims{"roles": []string{RoleOrganizer, RoleDonor}, "role": RoleAdmin}), testSecret),
+			want:  Caller{UserID: 42, Roles: []string{RoleOrganizer, RoleDonor, RoleAdmin}},
+		},
+		{
+			name:  "single role",
+			token: signHMAC(t, with(jwt.MapClaims{"role": RoleModerator}), testSecret),
+			want:  Caller{UserID: 42, Roles: []string{RoleModerator}},
+		},
+		{name: "expired", token: signHMAC(t, with(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}), testSecret), fails: true},
+		{name: "without expiry", token: signHMAC(t, with(jwt.MapClaims{"exp": nil}), testSecret), fails: true},
+		{name: "wrong secret", token: signHMAC(t, with(nil), "other-secret"), fails: true},
+		{name: "wrong issuer", token: signHMAC(t, with(jwt.MapClaims{"iss": "elsewhere"}), testSecret), fails: true},
+		{name: "wrong audience", token: signHMAC(t, with(jwt.MapClaims{"aud": "payments"}), testSecret), fails: true},
+		{name: "subject not a number", token: signHMAC(t, with(jwt.MapClaims{"sub": "alice"}), testSecret), fails: true},
+		{name: "subject zero", token: signHMAC(t, with(jwt.MapClaims{"sub": "0"}), testSecret), fails: true},
+		{name: "subject negative", token: signHMAC(t, with(jwt.MapClaims{"sub": "-7"}), testSecret), fails: true},
+		{name: "subject above int32", token: signHMAC(t, with(jwt.MapClaims{"sub": "2147483648"}), testSecret), fails: true},
+		{name: "without subject", token: signHMAC(t, with(jwt.MapClaims{"sub": nil}), testSecret), fails: true},
+		{name: "signed with RSA", token: signWithKey(t, jwt.SigningMethodRS256, "rsa", newRSAKey(t), with(nil)), fails: true},
+		{name: "not a token", token: "not.a.token", fails: true},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			caller, err := verifier.Verify(val.token)
+			if val.fails {
+				if err == nil {
+					t.Errorf("Verify = %+v, want an error", caller)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if caller.UserID != val.want.UserID || !slices.Equal(caller.Roles, val.want.Roles) {
+				t.Errorf("Verify = %+v, want %+v", caller, val.want)
+			}
+		})
+	}
+}
+
+func TestVerifyJWKSFile(t *testing.T) {
+	rsaKey, ecKey := newRSAKey(t), newECKey(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwksJSON(t, map[string]interface{}{"rsa": rsaKey, "ec": ecKey}), 0o600); err != nil {
+		t.Fatalf("writing JWKS: %v", err)
+	}
+	verifier, err := NewVerifier(VerifierConfig{JWKSSource: path})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+
+	cases := []struct {
+		name  string
+		token string
+		fails bool
+	}{
+		{name: "RSA key", token: signWithKey(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims())},
+		{name: "EC key", token: signWithKey(t, jwt.SigningMethodES256, "ec", ecKey, claims())},
+		{name: "key of another kid", token: signWithKey(t, jwt.SigningMethodRS256, "ec", rsaKey, claims()), fails: true},
+		{name: "unknown kid", token: signWithKey(t, jwt.SigningMethodRS256, "other", newRSAKey(t), claims()), fails: true},
+		{name: "HMAC is not accepted", token: signHMAC(t, claims(), testSecret), fails: true},
+		{
+			name:  "expired",
+			token: signWithKey(t, jwt.SigningMethodRS256, "rsa", rsaKey, jwt.MapClaims{"sub": "42", "exp": time.Now().Add(-time.Minute).Unix()}),
+			fails: true,
+		},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			caller, err := verifier.Verify(val.token)
+			if val.fails {
+				if err == nil {
+					t.Errorf("Verify = %+v, want an error", caller)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if caller.UserID != 42 {
+				t.Errorf("Verify user = %d, want 42", caller.UserID)
+			}
+		})
+	}
+}
+
+func TestVerifyJWKSReload(t *testing.T) {
+	oldKey, newKey := newRSAKey(t), newRSAKey(t)
+	server := newJWKSServer(t, jwksJSON(t, map[string]interface{}{"old": oldKey}))
+	verifier, err := NewVerifier(VerifierConfig{JWKSSource: server.URL})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	if server.requests() != 1 {
+		t.Fatalf("NewVerifier loaded the key set %d times, want 1", server.requests())
+	}
+
+	// The issuer rotates to a new key
+	server.serve(jwksJSON(t, map[string]interface{}{"old": oldKey, "new": newKey}))
+	rotated := signWithKey(t, jwt.SigningMethodRS256, "new", newKey, claims())
+
+	// Unknown kids do not hammer the key server while the set is fresh
+	if _, err := verifier.Verify(rotated); err == nil {
+		t.Error("Verify accepted an unknown kid before the key set was reloaded")
+	}
+	if server.requests() != 1 {
+		t.Errorf("a fresh key set was reloaded, %d requests", server.requests())
+	}
+
+	// Once the set is stale an unknown kid reloads it
+	verifier.mu.Lock()
+	verifier.loadedAt = time.Now().Add(-2 * jwksRefreshInterval)
+	verifier.mu.Unlock()
+	if _, err := verifier.Verify(rotated); err != nil {
+		t.Errorf("Verify after rotation: %v", err)
+	}
+	if server.requests() != 2 {
+		t.Errorf("key set loaded %d times, want 2", server.requests())
+	}
+	if _, err := verifier.Verify(signWithKey(t, jwt.SigningMethodRS256, "old", oldKey, claims())); err != nil {
+		t.Errorf("Verify with the previous key: %v", err)
+	}
+	if server.requests() != 2 {
+		t.Errorf("a known kid reloaded the key set, %d requests", server.requests())
+	}
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"log"
+	"os"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/auth"
+)
+
+// InitTokenVerifier builds the JWT verifier from the environment. JWT_HMAC_SECRET selects HMAC
+// signed tokens, otherwise JWT_JWKS_URL or JWT_JWKS_FILE point to the public keys. One of them is
+// required whatever STORAGE_BACKEND is, there is no unauthenticated mode.
+func InitTokenVerifier() *auth.Verifier {
+	cfg := auth.VerifierConfig{
+		HMACSecret: os.Getenv("JWT_HMAC_SECRET"),
+		JWKSSource: os.Getenv("JWT_JWKS_URL"),
+		Issuer:     os.Getenv("JWT_ISSUER"),
+		Audience:   os.Getenv("JWT_AUDIENCE"),
+	}
+	if cfg.JWKSSource == "" {
+		cfg.JWKSSource = os.Getenv("JWT_JWKS_FILE")
+	}
+
+	verifier, err := auth.NewVerifier(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize token verifier, set JWT_HMAC_SECRET, JWT_JWKS_URL or JWT_JWKS_FILE: %v", err)
+	}
+	return verifier
+}
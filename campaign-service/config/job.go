@@ -0,0 +1,26 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// default time between two runs of the campaign completion job
+const defaultCompletionInterval = time.Minute
+
+// CompletionInterval reads CAMPAIGN_COMPLETION_INTERVAL (e.g. "30s", "5m").
+// Zero disables the completion job.
+func CompletionInterval() time.Duration {
+	value := os.Getenv("CAMPAIGN_COMPLETION_INTERVAL")
+	if value == "" {
+		return defaultCompletionInterval
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < 0 {
+		log.Printf("Invalid CAMPAIGN_COMPLETION_INTERVAL %q, using %v", value, defaultCompletionInterval)
+		return defaultCompletionInterval
+	}
+	return interval
+}
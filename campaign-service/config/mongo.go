@@ -0,0 +1,42 @@
+package config
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// how long connecting to MongoDB may take on start
+const mongoConnectTimeout = 10 * time.Second
+
+// InitMongo connects to the MongoDB deployment at MONGO_URI and returns the MONGO_DB database.
+// DB_QUERY_TIMEOUT applies to every operation like it does to SQL statements.
+func InitMongo() *mongo.Database {
+	uri := os.Getenv("MONGO_URI")
+	dbName := os.Getenv("MONGO_DB")
+	if uri == "" || dbName == "" {
+		log.Fatal("MONGO_URI and MONGO_DB have to be set for the mongo storage backend")
+	}
+
+	opts := options.Client().ApplyURI(uri)
+	if timeout := QueryTimeout(); timeout > 0 {
+		opts.SetTimeout(timeout)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), mongoConnectTimeout)
+	defer cancel()
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB %v", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		log.Fatalf("Failed to reach MongoDB %v", err)
+	}
+
+	log.Println("Connected to MongoDB")
+	return client.Database(dbName)
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// default time a single database operation may run
+const defaultQueryTimeout = 5 * time.Second
+
+// QueryTimeout reads DB_QUERY_TIMEOUT (e.g. "2s", "500ms").
+// Zero disables the timeout, operations then only end with the gRPC call.
+func QueryTimeout() time.Duration {
+	value := os.Getenv("DB_QUERY_TIMEOUT")
+	if value == "" {
+		return defaultQueryTimeout
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		log.Printf("Invalid DB_QUERY_TIMEOUT %q, using %v", value, defaultQueryTimeout)
+		return defaultQueryTimeout
+	}
+	return timeout
+}
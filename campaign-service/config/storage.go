@@ -0,0 +1,29 @@
+package config
+
+import (
+	"log"
+	"os"
+)
+
+// Storage backends the repositories can run on
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+	StorageMongo    = "mongo"
+)
+
+// StorageBackend reads STORAGE_BACKEND, "postgres" (the default), "mongo" or "memory".
+// The memory backend needs no database and loses its data on restart, it is meant for local runs and CI.
+// Every backend still authenticates callers, so JWT_HMAC_SECRET, JWT_JWKS_URL or JWT_JWKS_FILE has to be
+// set as well, e.g. STORAGE_BACKEND=memory JWT_HMAC_SECRET=dev-secret for a local run.
+func StorageBackend() string {
+	value := os.Getenv("STORAGE_BACKEND")
+	switch value {
+	case "":
+		return StoragePostgres
+	case StoragePostgres, StorageMongo, StorageMemory:
+		return value
+	}
+	log.Fatalf("Unknown STORAGE_BACKEND %q, expected %q, %q or %q", value, StoragePostgres, StorageMongo, StorageMemory)
+	return ""
+}
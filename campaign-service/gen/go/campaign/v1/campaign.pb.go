@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -26,11 +27,14 @@ const (
 type CampaignStatus int32
 
 const (
-	CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED CampaignStatus = 0
-	CampaignStatus_CAMPAIGN_STATUS_ACTIVE      CampaignStatus = 1
-	CampaignStatus_CAMPAIGN_STATUS_PAUSED      CampaignStatus = 2
-	CampaignStatus_CAMPAIGN_STATUS_COMPLETED   CampaignStatus = 3
-	CampaignStatus_CAMPAIGN_STATUS_CANCELLED   CampaignStatus = 4
+	CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED    CampaignStatus = 0
+	CampaignStatus_CAMPAIGN_STATUS_ACTIVE         CampaignStatus = 1
+	CampaignStatus_CAMPAIGN_STATUS_PAUSED         CampaignStatus = 2
+	CampaignStatus_CAMPAIGN_STATUS_COMPLETED      CampaignStatus = 3
+	CampaignStatus_CAMPAIGN_STATUS_CANCELLED      CampaignStatus = 4
+	CampaignStatus_CAMPAIGN_STATUS_DRAFT          CampaignStatus = 5
+	CampaignStatus_CAMPAIGN_STATUS_PENDING_REVIEW CampaignStatus = 6
+	CampaignStatus_CAMPAIGN_STATUS_REJECTED       CampaignStatus = 7
 )
 
 // Enum value maps for CampaignStatus.
@@ -41,13 +45,19 @@ var (
 		2: "CAMPAIGN_STATUS_PAUSED",
 		3: "CAMPAIGN_STATUS_COMPLETED",
 		4: "CAMPAIGN_STATUS_CANCELLED",
+		5: "CAMPAIGN_STATUS_DRAFT",
+		6: "CAMPAIGN_STATUS_PENDING_REVIEW",
+		7: "CAMPAIGN_STATUS_REJECTED",
 	}
 	CampaignStatus_value = map[string]int32{
-		"CAMPAIGN_STATUS_UNSPECIFIED": 0,
-		"CAMPAIGN_STATUS_ACTIVE":      1,
-		"CAMPAIGN_STATUS_PAUSED":      2,
-		"CAMPAIGN_STATUS_COMPLETED":   3,
-		"CAMPAIGN_STATUS_CANCELLED":   4,
+		"CAMPAIGN_STATUS_UNSPECIFIED":    0,
+		"CAMPAIGN_STATUS_ACTIVE":         1,
+		"CAMPAIGN_STATUS_PAUSED":         2,
+		"CAMPAIGN_STATUS_COMPLETED":      3,
+		"CAMPAIGN_STATUS_CANCELLED":      4,
+		"CAMPAIGN_STATUS_DRAFT":          5,
+		"CAMPAIGN_STATUS_PENDING_REVIEW": 6,
+		"CAMPAIGN_STATUS_REJECTED":       7,
 	}
 )
 
@@ -148,27 +158,144 @@ func (CampaignCategory) EnumDescriptor() ([]byte, []int) {
 	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{1}
 }
 
+type CampaignSortField int32
+
+const (
+	CampaignSortField_CAMPAIGN_SORT_FIELD_UNSPECIFIED      CampaignSortField = 0
+	CampaignSortField_CAMPAIGN_SORT_FIELD_CREATED_AT       CampaignSortField = 1
+	CampaignSortField_CAMPAIGN_SORT_FIELD_DEADLINE         CampaignSortField = 2
+	CampaignSortField_CAMPAIGN_SORT_FIELD_COLLECTED_AMOUNT CampaignSortField = 3
+	CampaignSortField_CAMPAIGN_SORT_FIELD_PERCENT_FUNDED   CampaignSortField = 4
+)
+
+// Enum value maps for CampaignSortField.
+var (
+	CampaignSortField_name = map[int32]string{
+		0: "CAMPAIGN_SORT_FIELD_UNSPECIFIED",
+		1: "CAMPAIGN_SORT_FIELD_CREATED_AT",
+		2: "CAMPAIGN_SORT_FIELD_DEADLINE",
+		3: "CAMPAIGN_SORT_FIELD_COLLECTED_AMOUNT",
+		4: "CAMPAIGN_SORT_FIELD_PERCENT_FUNDED",
+	}
+	CampaignSortField_value = map[string]int32{
+		"CAMPAIGN_SORT_FIELD_UNSPECIFIED":      0,
+		"CAMPAIGN_SORT_FIELD_CREATED_AT":       1,
+		"CAMPAIGN_SORT_FIELD_DEADLINE":         2,
+		"CAMPAIGN_SORT_FIELD_COLLECTED_AMOUNT": 3,
+		"CAMPAIGN_SORT_FIELD_PERCENT_FUNDED":   4,
+	}
+)
+
+func (x CampaignSortField) Enum() *CampaignSortField {
+	p := new(CampaignSortField)
+	*p = x
+	return p
+}
+
+func (x CampaignSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_campaign_v1_campaign_proto_enumTypes[2].Descriptor()
+}
+
+func (CampaignSortField) Type() protoreflect.EnumType {
+	return &file_campaign_v1_campaign_proto_enumTypes[2]
+}
+
+func (x CampaignSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignSortField.Descriptor instead.
+func (CampaignSortField) EnumDescriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{2}
+}
+
+// Money is an exact amount in the minor unit of a currency (e.g. 150000 IDR is 15000000 units).
+// An empty currency_code is treated as IDR.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
 type Campaign struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	TargetAmount    int32                  `protobuf:"varint,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	CollectedAmount int32                  `protobuf:"varint,6,opt,name=collected_amount,json=collectedAmount,proto3" json:"collected_amount,omitempty"`
+	TargetAmount    *Money                 `protobuf:"bytes,13,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CollectedAmount *Money                 `protobuf:"bytes,14,opt,name=collected_amount,json=collectedAmount,proto3" json:"collected_amount,omitempty"`
 	Deadline        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	Status          CampaignStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=campaign.v1.CampaignStatus" json:"status,omitempty"`
 	Category        CampaignCategory       `protobuf:"varint,9,opt,name=category,proto3,enum=campaign.v1.CampaignCategory" json:"category,omitempty"`
-	MinDonation     int32                  `protobuf:"varint,10,opt,name=min_donation,json=minDonation,proto3" json:"min_donation,omitempty"`
+	MinDonation     *Money                 `protobuf:"bytes,15,opt,name=min_donation,json=minDonation,proto3" json:"min_donation,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Complete as soon as collected_amount reaches target_amount instead of waiting for the deadline
+	AutoCompleteOnTarget bool `protobuf:"varint,16,opt,name=auto_complete_on_target,json=autoCompleteOnTarget,proto3" json:"auto_complete_on_target,omitempty"`
+	// Set while the campaign is rejected by a moderator
+	RejectionReason string `protobuf:"bytes,17,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	// Only set on soft deleted campaigns, which are listed to admins only
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Changes whenever the campaign is edited or changes status, pass it back on writes
+	Etag          string `protobuf:"bytes,19,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Campaign) Reset() {
 	*x = Campaign{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[0]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -180,7 +307,7 @@ func (x *Campaign) String() string {
 func (*Campaign) ProtoMessage() {}
 
 func (x *Campaign) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[0]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,7 +320,7 @@ func (x *Campaign) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
 func (*Campaign) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{0}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Campaign) GetId() string {
@@ -224,18 +351,18 @@ func (x *Campaign) GetDescription() string {
 	return ""
 }
 
-func (x *Campaign) GetTargetAmount() int32 {
+func (x *Campaign) GetTargetAmount() *Money {
 	if x != nil {
 		return x.TargetAmount
 	}
-	return 0
+	return nil
 }
 
-func (x *Campaign) GetCollectedAmount() int32 {
+func (x *Campaign) GetCollectedAmount() *Money {
 	if x != nil {
 		return x.CollectedAmount
 	}
-	return 0
+	return nil
 }
 
 func (x *Campaign) GetDeadline() *timestamppb.Timestamp {
@@ -259,11 +386,11 @@ func (x *Campaign) GetCategory() CampaignCategory {
 	return CampaignCategory_CAMPAIGN_CATEGORY_UNSPECIFIED
 }
 
-func (x *Campaign) GetMinDonation() int32 {
+func (x *Campaign) GetMinDonation() *Money {
 	if x != nil {
 		return x.MinDonation
 	}
-	return 0
+	return nil
 }
 
 func (x *Campaign) GetCreatedAt() *timestamppb.Timestamp {
@@ -280,23 +407,58 @@ func (x *Campaign) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Campaign) GetAutoCompleteOnTarget() bool {
+	if x != nil {
+		return x.AutoCompleteOnTarget
+	}
+	return false
+}
+
+func (x *Campaign) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *Campaign) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Campaign) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 // Create Campaign
 type CreateCampaignRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	TargetAmount  int32                  `protobuf:"varint,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	Deadline      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Category      CampaignCategory       `protobuf:"varint,6,opt,name=category,proto3,enum=campaign.v1.CampaignCategory" json:"category,omitempty"`
-	MinDonation   int32                  `protobuf:"varint,7,opt,name=min_donation,json=minDonation,proto3" json:"min_donation,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ignored, the owner is the authenticated caller
+	//
+	// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+	UserId               int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title                string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TargetAmount         *Money                 `protobuf:"bytes,8,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	Deadline             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Category             CampaignCategory       `protobuf:"varint,6,opt,name=category,proto3,enum=campaign.v1.CampaignCategory" json:"category,omitempty"`
+	MinDonation          *Money                 `protobuf:"bytes,9,opt,name=min_donation,json=minDonation,proto3" json:"min_donation,omitempty"`
+	AutoCompleteOnTarget bool                   `protobuf:"varint,11,opt,name=auto_complete_on_target,json=autoCompleteOnTarget,proto3" json:"auto_complete_on_target,omitempty"`
+	// Retries with the same key return the first response instead of creating another campaign.
+	// The "idempotency-key" metadata header can be used instead.
+	IdempotencyKey string `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateCampaignRequest) Reset() {
 	*x = CreateCampaignRequest{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[1]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -308,7 +470,7 @@ func (x *CreateCampaignRequest) String() string {
 func (*CreateCampaignRequest) ProtoMessage() {}
 
 func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[1]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,9 +483,10 @@ func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
 func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{1}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{2}
 }
 
+// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
 func (x *CreateCampaignRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
@@ -345,11 +508,11 @@ func (x *CreateCampaignRequest) GetDescription() string {
 	return ""
 }
 
-func (x *CreateCampaignRequest) GetTargetAmount() int32 {
+func (x *CreateCampaignRequest) GetTargetAmount() *Money {
 	if x != nil {
 		return x.TargetAmount
 	}
-	return 0
+	return nil
 }
 
 func (x *CreateCampaignRequest) GetDeadline() *timestamppb.Timestamp {
@@ -366,11 +529,25 @@ func (x *CreateCampaignRequest) GetCategory() CampaignCategory {
 	return CampaignCategory_CAMPAIGN_CATEGORY_UNSPECIFIED
 }
 
-func (x *CreateCampaignRequest) GetMinDonation() int32 {
+func (x *CreateCampaignRequest) GetMinDonation() *Money {
 	if x != nil {
 		return x.MinDonation
 	}
-	return 0
+	return nil
+}
+
+func (x *CreateCampaignRequest) GetAutoCompleteOnTarget() bool {
+	if x != nil {
+		return x.AutoCompleteOnTarget
+	}
+	return false
+}
+
+func (x *CreateCampaignRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
 }
 
 type CreateCampaignResponse struct {
@@ -382,7 +559,7 @@ type CreateCampaignResponse struct {
 
 func (x *CreateCampaignResponse) Reset() {
 	*x = CreateCampaignResponse{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[2]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +571,7 @@ func (x *CreateCampaignResponse) String() string {
 func (*CreateCampaignResponse) ProtoMessage() {}
 
 func (x *CreateCampaignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[2]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +584,7 @@ func (x *CreateCampaignResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCampaignResponse.ProtoReflect.Descriptor instead.
 func (*CreateCampaignResponse) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{2}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateCampaignResponse) GetCreatedCampaign() []*Campaign {
@@ -427,7 +604,7 @@ type GetCampaignByIDRequest struct {
 
 func (x *GetCampaignByIDRequest) Reset() {
 	*x = GetCampaignByIDRequest{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[3]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +616,7 @@ func (x *GetCampaignByIDRequest) String() string {
 func (*GetCampaignByIDRequest) ProtoMessage() {}
 
 func (x *GetCampaignByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[3]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +629,7 @@ func (x *GetCampaignByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetCampaignByIDRequest) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{3}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetCampaignByIDRequest) GetId() string {
@@ -471,7 +648,7 @@ type GetCampaignByIDResponse struct {
 
 func (x *GetCampaignByIDResponse) Reset() {
 	*x = GetCampaignByIDResponse{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[4]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -483,7 +660,7 @@ func (x *GetCampaignByIDResponse) String() string {
 func (*GetCampaignByIDResponse) ProtoMessage() {}
 
 func (x *GetCampaignByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[4]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +673,7 @@ func (x *GetCampaignByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetCampaignByIDResponse) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{4}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetCampaignByIDResponse) GetCampaign() []*Campaign {
@@ -506,17 +683,19 @@ func (x *GetCampaignByIDResponse) GetCampaign() []*Campaign {
 	return nil
 }
 
-// Delete Campaign By ID
+// Delete Campaign By ID (owner or admin only)
 type DeleteCampaignByIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// etag of the campaign as last read, the call fails with ABORTED when it changed since
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCampaignByIDRequest) Reset() {
 	*x = DeleteCampaignByIDRequest{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[5]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -528,7 +707,7 @@ func (x *DeleteCampaignByIDRequest) String() string {
 func (*DeleteCampaignByIDRequest) ProtoMessage() {}
 
 func (x *DeleteCampaignByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[5]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +720,7 @@ func (x *DeleteCampaignByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCampaignByIDRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCampaignByIDRequest) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{5}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteCampaignByIDRequest) GetId() string {
@@ -551,6 +730,13 @@ func (x *DeleteCampaignByIDRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteCampaignByIDRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCampaignByIDResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	DeleteResponse *emptypb.Empty         `protobuf:"bytes,1,opt,name=delete_response,json=deleteResponse,proto3" json:"delete_response,omitempty"`
@@ -560,7 +746,7 @@ type DeleteCampaignByIDResponse struct {
 
 func (x *DeleteCampaignByIDResponse) Reset() {
 	*x = DeleteCampaignByIDResponse{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[6]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +758,7 @@ func (x *DeleteCampaignByIDResponse) String() string {
 func (*DeleteCampaignByIDResponse) ProtoMessage() {}
 
 func (x *DeleteCampaignByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[6]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +771,7 @@ func (x *DeleteCampaignByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCampaignByIDResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCampaignByIDResponse) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{6}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteCampaignByIDResponse) GetDeleteResponse() *emptypb.Empty {
@@ -597,23 +783,30 @@ func (x *DeleteCampaignByIDResponse) GetDeleteResponse() *emptypb.Empty {
 
 // Update Campaign By ID
 type UpdateCampaignByIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	TargetAmount  int32                  `protobuf:"varint,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	Deadline      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Status        CampaignStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=campaign.v1.CampaignStatus" json:"status,omitempty"`
-	Category      CampaignCategory       `protobuf:"varint,8,opt,name=category,proto3,enum=campaign.v1.CampaignCategory" json:"category,omitempty"`
-	MinDonation   int32                  `protobuf:"varint,9,opt,name=min_donation,json=minDonation,proto3" json:"min_donation,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ignored, the caller identity comes from the bearer token
+	//
+	// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+	UserId       int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title        string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TargetAmount *Money                 `protobuf:"bytes,10,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	Deadline     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status       CampaignStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=campaign.v1.CampaignStatus" json:"status,omitempty"`
+	Category     CampaignCategory       `protobuf:"varint,8,opt,name=category,proto3,enum=campaign.v1.CampaignCategory" json:"category,omitempty"`
+	MinDonation  *Money                 `protobuf:"bytes,11,opt,name=min_donation,json=minDonation,proto3" json:"min_donation,omitempty"`
+	// Fields to update, masked fields are written even when empty. Without a mask
+	// only the fields set to non-zero values are updated.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,12,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Etag          string                 `protobuf:"bytes,13,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCampaignByIDRequest) Reset() {
 	*x = UpdateCampaignByIDRequest{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[7]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -625,7 +818,7 @@ func (x *UpdateCampaignByIDRequest) String() string {
 func (*UpdateCampaignByIDRequest) ProtoMessage() {}
 
 func (x *UpdateCampaignByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[7]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +831,7 @@ func (x *UpdateCampaignByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCampaignByIDRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCampaignByIDRequest) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{7}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateCampaignByIDRequest) GetId() string {
@@ -648,6 +841,7 @@ func (x *UpdateCampaignByIDRequest) GetId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
 func (x *UpdateCampaignByIDRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
@@ -669,11 +863,11 @@ func (x *UpdateCampaignByIDRequest) GetDescription() string {
 	return ""
 }
 
-func (x *UpdateCampaignByIDRequest) GetTargetAmount() int32 {
+func (x *UpdateCampaignByIDRequest) GetTargetAmount() *Money {
 	if x != nil {
 		return x.TargetAmount
 	}
-	return 0
+	return nil
 }
 
 func (x *UpdateCampaignByIDRequest) GetDeadline() *timestamppb.Timestamp {
@@ -697,11 +891,25 @@ func (x *UpdateCampaignByIDRequest) GetCategory() CampaignCategory {
 	return CampaignCategory_CAMPAIGN_CATEGORY_UNSPECIFIED
 }
 
-func (x *UpdateCampaignByIDRequest) GetMinDonation() int32 {
+func (x *UpdateCampaignByIDRequest) GetMinDonation() *Money {
 	if x != nil {
 		return x.MinDonation
 	}
-	return 0
+	return nil
+}
+
+func (x *UpdateCampaignByIDRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateCampaignByIDRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
 }
 
 type UpdateCampaignByIDResponse struct {
@@ -713,7 +921,7 @@ type UpdateCampaignByIDResponse struct {
 
 func (x *UpdateCampaignByIDResponse) Reset() {
 	*x = UpdateCampaignByIDResponse{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[8]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -725,7 +933,7 @@ func (x *UpdateCampaignByIDResponse) String() string {
 func (*UpdateCampaignByIDResponse) ProtoMessage() {}
 
 func (x *UpdateCampaignByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[8]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,7 +946,7 @@ func (x *UpdateCampaignByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCampaignByIDResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCampaignByIDResponse) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{8}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateCampaignByIDResponse) GetUpdatedCampaign() []*Campaign {
@@ -748,17 +956,20 @@ func (x *UpdateCampaignByIDResponse) GetUpdatedCampaign() []*Campaign {
 	return nil
 }
 
-// Get Campaign By ID
+// Get Campaigns By User ID
 type GetCampaignsByUserIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Statuses      []CampaignStatus       `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=campaign.v1.CampaignStatus" json:"statuses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCampaignsByUserIDRequest) Reset() {
 	*x = GetCampaignsByUserIDRequest{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[9]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -770,7 +981,7 @@ func (x *GetCampaignsByUserIDRequest) String() string {
 func (*GetCampaignsByUserIDRequest) ProtoMessage() {}
 
 func (x *GetCampaignsByUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[9]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +994,7 @@ func (x *GetCampaignsByUserIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignsByUserIDRequest.ProtoReflect.Descriptor instead.
 func (*GetCampaignsByUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{9}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetCampaignsByUserIDRequest) GetUserId() int32 {
@@ -793,16 +1004,39 @@ func (x *GetCampaignsByUserIDRequest) GetUserId() int32 {
 	return 0
 }
 
+func (x *GetCampaignsByUserIDRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCampaignsByUserIDRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetCampaignsByUserIDRequest) GetStatuses() []CampaignStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
 type GetCampaignsByUserIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Campaign      []*Campaign            `protobuf:"bytes,1,rep,name=campaign,proto3" json:"campaign,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCampaignsByUserIDResponse) Reset() {
 	*x = GetCampaignsByUserIDResponse{}
-	mi := &file_campaign_v1_campaign_proto_msgTypes[10]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1048,7 @@ func (x *GetCampaignsByUserIDResponse) String() string {
 func (*GetCampaignsByUserIDResponse) ProtoMessage() {}
 
 func (x *GetCampaignsByUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_campaign_v1_campaign_proto_msgTypes[10]
+	mi := &file_campaign_v1_campaign_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1061,7 @@ func (x *GetCampaignsByUserIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCampaignsByUserIDResponse.ProtoReflect.Descriptor instead.
 func (*GetCampaignsByUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{10}
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetCampaignsByUserIDResponse) GetCampaign() []*Campaign {
@@ -837,67 +1071,2645 @@ func (x *GetCampaignsByUserIDResponse) GetCampaign() []*Campaign {
 	return nil
 }
 
-var File_campaign_v1_campaign_proto protoreflect.FileDescriptor
+func (x *GetCampaignsByUserIDResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_campaign_v1_campaign_proto_rawDesc = "" +
-	"\n" +
-	"\x1acampaign/v1/campaign.proto\x12\vcampaign.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xfc\x03\n" +
-	"\bCampaign\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
-	"\rtarget_amount\x18\x05 \x01(\x05R\ftargetAmount\x12)\n" +
-	"\x10collected_amount\x18\x06 \x01(\x05R\x0fcollectedAmount\x126\n" +
-	"\bdeadline\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x123\n" +
-	"\x06status\x18\b \x01(\x0e2\x1b.campaign.v1.CampaignStatusR\x06status\x129\n" +
-	"\bcategory\x18\t \x01(\x0e2\x1d.campaign.v1.CampaignCategoryR\bcategory\x12!\n" +
-	"\fmin_donation\x18\n" +
-	" \x01(\x05R\vminDonation\x129\n" +
-	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa3\x02\n" +
-	"\x15CreateCampaignRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
-	"\rtarget_amount\x18\x04 \x01(\x05R\ftargetAmount\x126\n" +
-	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x129\n" +
-	"\bcategory\x18\x06 \x01(\x0e2\x1d.campaign.v1.CampaignCategoryR\bcategory\x12!\n" +
-	"\fmin_donation\x18\a \x01(\x05R\vminDonation\"Z\n" +
-	"\x16CreateCampaignResponse\x12@\n" +
-	"\x10created_campaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\x0fcreatedCampaign\"(\n" +
-	"\x16GetCampaignByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
-	"\x17GetCampaignByIDResponse\x121\n" +
-	"\bcampaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\bcampaign\"+\n" +
-	"\x19DeleteCampaignByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
-	"\x1aDeleteCampaignByIDResponse\x12?\n" +
-	"\x0fdelete_response\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x0edeleteResponse\"\xec\x02\n" +
-	"\x19UpdateCampaignByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
-	"\rtarget_amount\x18\x05 \x01(\x05R\ftargetAmount\x126\n" +
-	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x123\n" +
-	"\x06status\x18\a \x01(\x0e2\x1b.campaign.v1.CampaignStatusR\x06status\x129\n" +
-	"\bcategory\x18\b \x01(\x0e2\x1d.campaign.v1.CampaignCategoryR\bcategory\x12!\n" +
-	"\fmin_donation\x18\t \x01(\x05R\vminDonation\"^\n" +
-	"\x1aUpdateCampaignByIDResponse\x12@\n" +
-	"\x10updated_campaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\x0fupdatedCampaign\"6\n" +
-	"\x1bGetCampaignsByUserIDRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"Q\n" +
-	"\x1cGetCampaignsByUserIDResponse\x121\n" +
-	"\bcampaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\bcampaign*\xa7\x01\n" +
+func (x *GetCampaignsByUserIDResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// List Campaigns
+type ListCampaignsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Statuses     []CampaignStatus       `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=campaign.v1.CampaignStatus" json:"statuses,omitempty"`
+	Categories   []CampaignCategory     `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=campaign.v1.CampaignCategory" json:"categories,omitempty"`
+	DeadlineFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deadline_from,json=deadlineFrom,proto3" json:"deadline_from,omitempty"`
+	DeadlineTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deadline_to,json=deadlineTo,proto3" json:"deadline_to,omitempty"`
+	// Target range in minor units of currency_code
+	MinTargetAmount *int64            `protobuf:"varint,5,opt,name=min_target_amount,json=minTargetAmount,proto3,oneof" json:"min_target_amount,omitempty"`
+	MaxTargetAmount *int64            `protobuf:"varint,6,opt,name=max_target_amount,json=maxTargetAmount,proto3,oneof" json:"max_target_amount,omitempty"`
+	SortBy          CampaignSortField `protobuf:"varint,7,opt,name=sort_by,json=sortBy,proto3,enum=campaign.v1.CampaignSortField" json:"sort_by,omitempty"`
+	Descending      bool              `protobuf:"varint,8,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize        int32             `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string            `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CurrencyCode    string            `protobuf:"bytes,11,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCampaignsRequest) Reset() {
+	*x = ListCampaignsRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsRequest) ProtoMessage() {}
+
+func (x *ListCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListCampaignsRequest) GetStatuses() []CampaignStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListCampaignsRequest) GetCategories() []CampaignCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCampaignsRequest) GetDeadlineFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadlineFrom
+	}
+	return nil
+}
+
+func (x *ListCampaignsRequest) GetDeadlineTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadlineTo
+	}
+	return nil
+}
+
+func (x *ListCampaignsRequest) GetMinTargetAmount() int64 {
+	if x != nil && x.MinTargetAmount != nil {
+		return *x.MinTargetAmount
+	}
+	return 0
+}
+
+func (x *ListCampaignsRequest) GetMaxTargetAmount() int64 {
+	if x != nil && x.MaxTargetAmount != nil {
+		return *x.MaxTargetAmount
+	}
+	return 0
+}
+
+func (x *ListCampaignsRequest) GetSortBy() CampaignSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return CampaignSortField_CAMPAIGN_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListCampaignsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListCampaignsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCampaignsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCampaignsRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type ListCampaignsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      []*Campaign            `protobuf:"bytes,1,rep,name=campaign,proto3" json:"campaign,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsResponse) Reset() {
+	*x = ListCampaignsResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsResponse) ProtoMessage() {}
+
+func (x *ListCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCampaignsResponse) GetCampaign() []*Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *ListCampaignsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Search Campaigns
+type SearchCampaignsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Statuses      []CampaignStatus       `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=campaign.v1.CampaignStatus" json:"statuses,omitempty"`
+	Categories    []CampaignCategory     `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=campaign.v1.CampaignCategory" json:"categories,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCampaignsRequest) Reset() {
+	*x = SearchCampaignsRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCampaignsRequest) ProtoMessage() {}
+
+func (x *SearchCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchCampaignsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCampaignsRequest) GetStatuses() []CampaignStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SearchCampaignsRequest) GetCategories() []CampaignCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SearchCampaignsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchCampaignsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchCampaignResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Campaign *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Rank     float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Matched words are wrapped in <mark></mark>, the campaign text is HTML escaped
+	TitleHighlight       string `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionHighlight string `protobuf:"bytes,4,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SearchCampaignResult) Reset() {
+	*x = SearchCampaignResult{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCampaignResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCampaignResult) ProtoMessage() {}
+
+func (x *SearchCampaignResult) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCampaignResult.ProtoReflect.Descriptor instead.
+func (*SearchCampaignResult) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchCampaignResult) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *SearchCampaignResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchCampaignResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchCampaignResult) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+type SearchCampaignsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Result        []*SearchCampaignResult `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCampaignsResponse) Reset() {
+	*x = SearchCampaignsResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCampaignsResponse) ProtoMessage() {}
+
+func (x *SearchCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*SearchCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchCampaignsResponse) GetResult() []*SearchCampaignResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SearchCampaignsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Record Contribution
+type RecordContributionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Id of the donation in the donation service
+	ContributionId string `protobuf:"bytes,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	Amount         *Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Defaults to contribution_id. The "idempotency-key" metadata header can be used instead.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Where the money came from, defaults to "donation"
+	Source        string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordContributionRequest) Reset() {
+	*x = RecordContributionRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordContributionRequest) ProtoMessage() {}
+
+func (x *RecordContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordContributionRequest.ProtoReflect.Descriptor instead.
+func (*RecordContributionRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RecordContributionRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetContributionId() string {
+	if x != nil {
+		return x.ContributionId
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RecordContributionRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type RecordContributionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CollectedAmount *Money                 `protobuf:"bytes,1,opt,name=collected_amount,json=collectedAmount,proto3" json:"collected_amount,omitempty"`
+	Campaign        *Campaign              `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecordContributionResponse) Reset() {
+	*x = RecordContributionResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordContributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordContributionResponse) ProtoMessage() {}
+
+func (x *RecordContributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordContributionResponse.ProtoReflect.Descriptor instead.
+func (*RecordContributionResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecordContributionResponse) GetCollectedAmount() *Money {
+	if x != nil {
+		return x.CollectedAmount
+	}
+	return nil
+}
+
+func (x *RecordContributionResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// Reverse Contribution (refund or chargeback)
+type ReverseContributionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Id of the original donation in the donation service
+	ContributionId string `protobuf:"bytes,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	// Id of the refund or chargeback in the payment service
+	ReversalId string `protobuf:"bytes,3,opt,name=reversal_id,json=reversalId,proto3" json:"reversal_id,omitempty"`
+	Amount     *Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Defaults to reversal_id. The "idempotency-key" metadata header can be used instead.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReverseContributionRequest) Reset() {
+	*x = ReverseContributionRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseContributionRequest) ProtoMessage() {}
+
+func (x *ReverseContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseContributionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseContributionRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReverseContributionRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ReverseContributionRequest) GetContributionId() string {
+	if x != nil {
+		return x.ContributionId
+	}
+	return ""
+}
+
+func (x *ReverseContributionRequest) GetReversalId() string {
+	if x != nil {
+		return x.ReversalId
+	}
+	return ""
+}
+
+func (x *ReverseContributionRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ReverseContributionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReverseContributionRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ReverseContributionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CollectedAmount *Money                 `protobuf:"bytes,1,opt,name=collected_amount,json=collectedAmount,proto3" json:"collected_amount,omitempty"`
+	Campaign        *Campaign              `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReverseContributionResponse) Reset() {
+	*x = ReverseContributionResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseContributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseContributionResponse) ProtoMessage() {}
+
+func (x *ReverseContributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseContributionResponse.ProtoReflect.Descriptor instead.
+func (*ReverseContributionResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReverseContributionResponse) GetCollectedAmount() *Money {
+	if x != nil {
+		return x.CollectedAmount
+	}
+	return nil
+}
+
+func (x *ReverseContributionResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// Reconcile Campaign
+type ReconcileCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Overwrite collected_amount with the ledger amount when they differ
+	Fix           bool `protobuf:"varint,2,opt,name=fix,proto3" json:"fix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCampaignRequest) Reset() {
+	*x = ReconcileCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCampaignRequest) ProtoMessage() {}
+
+func (x *ReconcileCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReconcileCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReconcileCampaignRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type ReconcileCampaignResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Campaign *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	// Sum of contributions minus sum of reversals
+	LedgerAmount *Money `protobuf:"bytes,2,opt,name=ledger_amount,json=ledgerAmount,proto3" json:"ledger_amount,omitempty"`
+	// Stored collected_amount minus ledger_amount, before any fix
+	Drift         *Money `protobuf:"bytes,3,opt,name=drift,proto3" json:"drift,omitempty"`
+	Fixed         bool   `protobuf:"varint,4,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCampaignResponse) Reset() {
+	*x = ReconcileCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCampaignResponse) ProtoMessage() {}
+
+func (x *ReconcileCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCampaignResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReconcileCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *ReconcileCampaignResponse) GetLedgerAmount() *Money {
+	if x != nil {
+		return x.LedgerAmount
+	}
+	return nil
+}
+
+func (x *ReconcileCampaignResponse) GetDrift() *Money {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *ReconcileCampaignResponse) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+// Pause, Resume and Cancel Campaign
+type PauseCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ignored, the caller identity comes from the bearer token
+	//
+	// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+	UserId        int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Etag          string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseCampaignRequest) Reset() {
+	*x = PauseCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCampaignRequest) ProtoMessage() {}
+
+func (x *PauseCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCampaignRequest.ProtoReflect.Descriptor instead.
+func (*PauseCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PauseCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+func (x *PauseCampaignRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PauseCampaignRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PauseCampaignRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type PauseCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseCampaignResponse) Reset() {
+	*x = PauseCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCampaignResponse) ProtoMessage() {}
+
+func (x *PauseCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCampaignResponse.ProtoReflect.Descriptor instead.
+func (*PauseCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PauseCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ResumeCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ignored, the caller identity comes from the bearer token
+	//
+	// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+	UserId        int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Etag          string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeCampaignRequest) Reset() {
+	*x = ResumeCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCampaignRequest) ProtoMessage() {}
+
+func (x *ResumeCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ResumeCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResumeCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+func (x *ResumeCampaignRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ResumeCampaignRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResumeCampaignRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ResumeCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeCampaignResponse) Reset() {
+	*x = ResumeCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCampaignResponse) ProtoMessage() {}
+
+func (x *ResumeCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeCampaignResponse.ProtoReflect.Descriptor instead.
+func (*ResumeCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResumeCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type CancelCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ignored, the caller identity comes from the bearer token
+	//
+	// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+	UserId        int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Etag          string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignRequest) Reset() {
+	*x = CancelCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignRequest) ProtoMessage() {}
+
+func (x *CancelCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CancelCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CancelCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+func (x *CancelCampaignRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelCampaignRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelCampaignRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CancelCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignResponse) Reset() {
+	*x = CancelCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignResponse) ProtoMessage() {}
+
+func (x *CancelCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CancelCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// Publish Campaign (deprecated, same as SubmitForReview)
+type PublishCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ignored, the caller identity comes from the bearer token
+	//
+	// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+	UserId        int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishCampaignRequest) Reset() {
+	*x = PublishCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishCampaignRequest) ProtoMessage() {}
+
+func (x *PublishCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishCampaignRequest.ProtoReflect.Descriptor instead.
+func (*PublishCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PublishCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+func (x *PublishCampaignRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishCampaignRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type PublishCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishCampaignResponse) Reset() {
+	*x = PublishCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishCampaignResponse) ProtoMessage() {}
+
+func (x *PublishCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishCampaignResponse.ProtoReflect.Descriptor instead.
+func (*PublishCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PublishCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// Moderation
+type SubmitForReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ignored, the caller identity comes from the bearer token
+	//
+	// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+	UserId        int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewRequest) Reset() {
+	*x = SubmitForReviewRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewRequest) ProtoMessage() {}
+
+func (x *SubmitForReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubmitForReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in campaign/v1/campaign.proto.
+func (x *SubmitForReviewRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitForReviewRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type SubmitForReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewResponse) Reset() {
+	*x = SubmitForReviewResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewResponse) ProtoMessage() {}
+
+func (x *SubmitForReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubmitForReviewResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ApproveCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCampaignRequest) Reset() {
+	*x = ApproveCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCampaignRequest) ProtoMessage() {}
+
+func (x *ApproveCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ApproveCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveCampaignRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ApproveCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCampaignResponse) Reset() {
+	*x = ApproveCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCampaignResponse) ProtoMessage() {}
+
+func (x *ApproveCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCampaignResponse.ProtoReflect.Descriptor instead.
+func (*ApproveCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ApproveCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type RejectCampaignRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// etag of the campaign as last read, the call fails with ABORTED when it changed since
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCampaignRequest) Reset() {
+	*x = RejectCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCampaignRequest) ProtoMessage() {}
+
+func (x *RejectCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCampaignRequest.ProtoReflect.Descriptor instead.
+func (*RejectCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RejectCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectCampaignRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectCampaignRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type RejectCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCampaignResponse) Reset() {
+	*x = RejectCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCampaignResponse) ProtoMessage() {}
+
+func (x *RejectCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCampaignResponse.ProtoReflect.Descriptor instead.
+func (*RejectCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RejectCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ListPendingReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingReviewsRequest) Reset() {
+	*x = ListPendingReviewsRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingReviewsRequest) ProtoMessage() {}
+
+func (x *ListPendingReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListPendingReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPendingReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      []*Campaign            `protobuf:"bytes,1,rep,name=campaign,proto3" json:"campaign,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingReviewsResponse) Reset() {
+	*x = ListPendingReviewsResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingReviewsResponse) ProtoMessage() {}
+
+func (x *ListPendingReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListPendingReviewsResponse) GetCampaign() []*Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *ListPendingReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Admin operations
+type ForceCancelCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceCancelCampaignRequest) Reset() {
+	*x = ForceCancelCampaignRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceCancelCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceCancelCampaignRequest) ProtoMessage() {}
+
+func (x *ForceCancelCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceCancelCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ForceCancelCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ForceCancelCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceCancelCampaignRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ForceCancelCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceCancelCampaignResponse) Reset() {
+	*x = ForceCancelCampaignResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceCancelCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceCancelCampaignResponse) ProtoMessage() {}
+
+func (x *ForceCancelCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceCancelCampaignResponse.ProtoReflect.Descriptor instead.
+func (*ForceCancelCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ForceCancelCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ListAllCampaignsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty means every status, including drafts and campaigns under review
+	Statuses       []CampaignStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=campaign.v1.CampaignStatus" json:"statuses,omitempty"`
+	IncludeDeleted bool             `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	PageSize       int32            `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string           `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAllCampaignsRequest) Reset() {
+	*x = ListAllCampaignsRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllCampaignsRequest) ProtoMessage() {}
+
+func (x *ListAllCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAllCampaignsRequest) GetStatuses() []CampaignStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListAllCampaignsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListAllCampaignsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAllCampaignsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAllCampaignsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      []*Campaign            `protobuf:"bytes,1,rep,name=campaign,proto3" json:"campaign,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllCampaignsResponse) Reset() {
+	*x = ListAllCampaignsResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllCampaignsResponse) ProtoMessage() {}
+
+func (x *ListAllCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListAllCampaignsResponse) GetCampaign() []*Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *ListAllCampaignsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Campaign Updates (news posts by the campaign owner)
+type CampaignUpdate struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignId string                 `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	UserId     int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title      string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// Up to 2000 characters
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignUpdate) Reset() {
+	*x = CampaignUpdate{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignUpdate) ProtoMessage() {}
+
+func (x *CampaignUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignUpdate.ProtoReflect.Descriptor instead.
+func (*CampaignUpdate) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CampaignUpdate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CampaignUpdate) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CampaignUpdate) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CampaignUpdate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CampaignUpdate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CampaignUpdate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CampaignUpdate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateCampaignUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignUpdateRequest) Reset() {
+	*x = CreateCampaignUpdateRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignUpdateRequest) ProtoMessage() {}
+
+func (x *CreateCampaignUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateCampaignUpdateRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CreateCampaignUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateCampaignUpdateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateCampaignUpdateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CampaignUpdate *CampaignUpdate        `protobuf:"bytes,1,opt,name=campaign_update,json=campaignUpdate,proto3" json:"campaign_update,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCampaignUpdateResponse) Reset() {
+	*x = CreateCampaignUpdateResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignUpdateResponse) ProtoMessage() {}
+
+func (x *CreateCampaignUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignUpdateResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampaignUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateCampaignUpdateResponse) GetCampaignUpdate() *CampaignUpdate {
+	if x != nil {
+		return x.CampaignUpdate
+	}
+	return nil
+}
+
+type ListCampaignUpdatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignUpdatesRequest) Reset() {
+	*x = ListCampaignUpdatesRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignUpdatesRequest) ProtoMessage() {}
+
+func (x *ListCampaignUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListCampaignUpdatesRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListCampaignUpdatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCampaignUpdatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCampaignUpdatesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CampaignUpdate []*CampaignUpdate      `protobuf:"bytes,1,rep,name=campaign_update,json=campaignUpdate,proto3" json:"campaign_update,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCampaignUpdatesResponse) Reset() {
+	*x = ListCampaignUpdatesResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignUpdatesResponse) ProtoMessage() {}
+
+func (x *ListCampaignUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListCampaignUpdatesResponse) GetCampaignUpdate() []*CampaignUpdate {
+	if x != nil {
+		return x.CampaignUpdate
+	}
+	return nil
+}
+
+func (x *ListCampaignUpdatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type EditCampaignUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCampaignUpdateRequest) Reset() {
+	*x = EditCampaignUpdateRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCampaignUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCampaignUpdateRequest) ProtoMessage() {}
+
+func (x *EditCampaignUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCampaignUpdateRequest.ProtoReflect.Descriptor instead.
+func (*EditCampaignUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *EditCampaignUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditCampaignUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EditCampaignUpdateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type EditCampaignUpdateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CampaignUpdate *CampaignUpdate        `protobuf:"bytes,1,opt,name=campaign_update,json=campaignUpdate,proto3" json:"campaign_update,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EditCampaignUpdateResponse) Reset() {
+	*x = EditCampaignUpdateResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCampaignUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCampaignUpdateResponse) ProtoMessage() {}
+
+func (x *EditCampaignUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCampaignUpdateResponse.ProtoReflect.Descriptor instead.
+func (*EditCampaignUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EditCampaignUpdateResponse) GetCampaignUpdate() *CampaignUpdate {
+	if x != nil {
+		return x.CampaignUpdate
+	}
+	return nil
+}
+
+type DeleteCampaignUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCampaignUpdateRequest) Reset() {
+	*x = DeleteCampaignUpdateRequest{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCampaignUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCampaignUpdateRequest) ProtoMessage() {}
+
+func (x *DeleteCampaignUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCampaignUpdateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCampaignUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteCampaignUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCampaignUpdateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeleteResponse *emptypb.Empty         `protobuf:"bytes,1,opt,name=delete_response,json=deleteResponse,proto3" json:"delete_response,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteCampaignUpdateResponse) Reset() {
+	*x = DeleteCampaignUpdateResponse{}
+	mi := &file_campaign_v1_campaign_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCampaignUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCampaignUpdateResponse) ProtoMessage() {}
+
+func (x *DeleteCampaignUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_campaign_v1_campaign_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCampaignUpdateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCampaignUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_campaign_v1_campaign_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteCampaignUpdateResponse) GetDeleteResponse() *emptypb.Empty {
+	if x != nil {
+		return x.DeleteResponse
+	}
+	return nil
+}
+
+var File_campaign_v1_campaign_proto protoreflect.FileDescriptor
+
+const file_campaign_v1_campaign_proto_rawDesc = "" +
+	"\n" +
+	"\x1acampaign/v1/campaign.proto\x12\vcampaign.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"B\n" +
+	"\x05Money\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\"\xfb\x05\n" +
+	"\bCampaign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x127\n" +
+	"\rtarget_amount\x18\r \x01(\v2\x12.campaign.v1.MoneyR\ftargetAmount\x12=\n" +
+	"\x10collected_amount\x18\x0e \x01(\v2\x12.campaign.v1.MoneyR\x0fcollectedAmount\x126\n" +
+	"\bdeadline\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x123\n" +
+	"\x06status\x18\b \x01(\x0e2\x1b.campaign.v1.CampaignStatusR\x06status\x129\n" +
+	"\bcategory\x18\t \x01(\x0e2\x1d.campaign.v1.CampaignCategoryR\bcategory\x125\n" +
+	"\fmin_donation\x18\x0f \x01(\v2\x12.campaign.v1.MoneyR\vminDonation\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x17auto_complete_on_target\x18\x10 \x01(\bR\x14autoCompleteOnTarget\x12)\n" +
+	"\x10rejection_reason\x18\x11 \x01(\tR\x0frejectionReason\x129\n" +
+	"\n" +
+	"deleted_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04etag\x18\x13 \x01(\tR\x04etagJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aJ\x04\b\n" +
+	"\x10\v\"\xbb\x03\n" +
+	"\x15CreateCampaignRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x05B\x02\x18\x01R\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
+	"\rtarget_amount\x18\b \x01(\v2\x12.campaign.v1.MoneyR\ftargetAmount\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x129\n" +
+	"\bcategory\x18\x06 \x01(\x0e2\x1d.campaign.v1.CampaignCategoryR\bcategory\x125\n" +
+	"\fmin_donation\x18\t \x01(\v2\x12.campaign.v1.MoneyR\vminDonation\x125\n" +
+	"\x17auto_complete_on_target\x18\v \x01(\bR\x14autoCompleteOnTarget\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKeyJ\x04\b\x04\x10\x05J\x04\b\a\x10\b\"Z\n" +
+	"\x16CreateCampaignResponse\x12@\n" +
+	"\x10created_campaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\x0fcreatedCampaign\"(\n" +
+	"\x16GetCampaignByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x17GetCampaignByIDResponse\x121\n" +
+	"\bcampaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\bcampaign\"?\n" +
+	"\x19DeleteCampaignByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"]\n" +
+	"\x1aDeleteCampaignByIDResponse\x12?\n" +
+	"\x0fdelete_response\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x0edeleteResponse\"\xf5\x03\n" +
+	"\x19UpdateCampaignByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\x05B\x02\x18\x01R\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x127\n" +
+	"\rtarget_amount\x18\n" +
+	" \x01(\v2\x12.campaign.v1.MoneyR\ftargetAmount\x126\n" +
+	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x123\n" +
+	"\x06status\x18\a \x01(\x0e2\x1b.campaign.v1.CampaignStatusR\x06status\x129\n" +
+	"\bcategory\x18\b \x01(\x0e2\x1d.campaign.v1.CampaignCategoryR\bcategory\x125\n" +
+	"\fmin_donation\x18\v \x01(\v2\x12.campaign.v1.MoneyR\vminDonation\x12;\n" +
+	"\vupdate_mask\x18\f \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\r \x01(\tR\x04etagJ\x04\b\x05\x10\x06J\x04\b\t\x10\n" +
+	"\"^\n" +
+	"\x1aUpdateCampaignByIDResponse\x12@\n" +
+	"\x10updated_campaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\x0fupdatedCampaign\"\xab\x01\n" +
+	"\x1bGetCampaignsByUserIDRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x127\n" +
+	"\bstatuses\x18\x04 \x03(\x0e2\x1b.campaign.v1.CampaignStatusR\bstatuses\"\x9a\x01\n" +
+	"\x1cGetCampaignsByUserIDResponse\x121\n" +
+	"\bcampaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\bcampaign\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\xd4\x04\n" +
+	"\x14ListCampaignsRequest\x127\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x1b.campaign.v1.CampaignStatusR\bstatuses\x12=\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\x0e2\x1d.campaign.v1.CampaignCategoryR\n" +
+	"categories\x12?\n" +
+	"\rdeadline_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fdeadlineFrom\x12;\n" +
+	"\vdeadline_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deadlineTo\x12/\n" +
+	"\x11min_target_amount\x18\x05 \x01(\x03H\x00R\x0fminTargetAmount\x88\x01\x01\x12/\n" +
+	"\x11max_target_amount\x18\x06 \x01(\x03H\x01R\x0fmaxTargetAmount\x88\x01\x01\x127\n" +
+	"\asort_by\x18\a \x01(\x0e2\x1e.campaign.v1.CampaignSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\b \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12#\n" +
+	"\rcurrency_code\x18\v \x01(\tR\fcurrencyCodeB\x14\n" +
+	"\x12_min_target_amountB\x14\n" +
+	"\x12_max_target_amount\"r\n" +
+	"\x15ListCampaignsResponse\x121\n" +
+	"\bcampaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\bcampaign\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe2\x01\n" +
+	"\x16SearchCampaignsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x127\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x1b.campaign.v1.CampaignStatusR\bstatuses\x12=\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\x0e2\x1d.campaign.v1.CampaignCategoryR\n" +
+	"categories\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xbb\x01\n" +
+	"\x14SearchCampaignResult\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12'\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0etitleHighlight\x123\n" +
+	"\x15description_highlight\x18\x04 \x01(\tR\x14descriptionHighlight\"|\n" +
+	"\x17SearchCampaignsResponse\x129\n" +
+	"\x06result\x18\x01 \x03(\v2!.campaign.v1.SearchCampaignResultR\x06result\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd2\x01\n" +
+	"\x19RecordContributionRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12'\n" +
+	"\x0fcontribution_id\x18\x02 \x01(\tR\x0econtributionId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.campaign.v1.MoneyR\x06amount\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\"\x8e\x01\n" +
+	"\x1aRecordContributionResponse\x12=\n" +
+	"\x10collected_amount\x18\x01 \x01(\v2\x12.campaign.v1.MoneyR\x0fcollectedAmount\x121\n" +
+	"\bcampaign\x18\x02 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"\xf4\x01\n" +
+	"\x1aReverseContributionRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12'\n" +
+	"\x0fcontribution_id\x18\x02 \x01(\tR\x0econtributionId\x12\x1f\n" +
+	"\vreversal_id\x18\x03 \x01(\tR\n" +
+	"reversalId\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.campaign.v1.MoneyR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\x8f\x01\n" +
+	"\x1bReverseContributionResponse\x12=\n" +
+	"\x10collected_amount\x18\x01 \x01(\v2\x12.campaign.v1.MoneyR\x0fcollectedAmount\x121\n" +
+	"\bcampaign\x18\x02 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"<\n" +
+	"\x18ReconcileCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03fix\x18\x02 \x01(\bR\x03fix\"\xc7\x01\n" +
+	"\x19ReconcileCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\x127\n" +
+	"\rledger_amount\x18\x02 \x01(\v2\x12.campaign.v1.MoneyR\fledgerAmount\x12(\n" +
+	"\x05drift\x18\x03 \x01(\v2\x12.campaign.v1.MoneyR\x05drift\x12\x14\n" +
+	"\x05fixed\x18\x04 \x01(\bR\x05fixed\"o\n" +
+	"\x14PauseCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\x05B\x02\x18\x01R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"J\n" +
+	"\x15PauseCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"p\n" +
+	"\x15ResumeCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\x05B\x02\x18\x01R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"K\n" +
+	"\x16ResumeCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"p\n" +
+	"\x15CancelCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\x05B\x02\x18\x01R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"K\n" +
+	"\x16CancelCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"Y\n" +
+	"\x16PublishCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\x05B\x02\x18\x01R\x06userId\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"L\n" +
+	"\x17PublishCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"Y\n" +
+	"\x16SubmitForReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\x05B\x02\x18\x01R\x06userId\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"L\n" +
+	"\x17SubmitForReviewResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"<\n" +
+	"\x16ApproveCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"L\n" +
+	"\x17ApproveCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"S\n" +
+	"\x15RejectCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"K\n" +
+	"\x16RejectCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"W\n" +
+	"\x19ListPendingReviewsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"w\n" +
+	"\x1aListPendingReviewsResponse\x121\n" +
+	"\bcampaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\bcampaign\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"D\n" +
+	"\x1aForceCancelCampaignRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"P\n" +
+	"\x1bForceCancelCampaignResponse\x121\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x15.campaign.v1.CampaignR\bcampaign\"\xb7\x01\n" +
+	"\x17ListAllCampaignsRequest\x127\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x1b.campaign.v1.CampaignStatusR\bstatuses\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"u\n" +
+	"\x18ListAllCampaignsResponse\x121\n" +
+	"\bcampaign\x18\x01 \x03(\v2\x15.campaign.v1.CampaignR\bcampaign\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x80\x02\n" +
+	"\x0eCampaignUpdate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcampaign_id\x18\x02 \x01(\tR\n" +
+	"campaignId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"n\n" +
+	"\x1bCreateCampaignUpdateRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"d\n" +
+	"\x1cCreateCampaignUpdateResponse\x12D\n" +
+	"\x0fcampaign_update\x18\x01 \x01(\v2\x1b.campaign.v1.CampaignUpdateR\x0ecampaignUpdate\"y\n" +
+	"\x1aListCampaignUpdatesRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8b\x01\n" +
+	"\x1bListCampaignUpdatesResponse\x12D\n" +
+	"\x0fcampaign_update\x18\x01 \x03(\v2\x1b.campaign.v1.CampaignUpdateR\x0ecampaignUpdate\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"[\n" +
+	"\x19EditCampaignUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"b\n" +
+	"\x1aEditCampaignUpdateResponse\x12D\n" +
+	"\x0fcampaign_update\x18\x01 \x01(\v2\x1b.campaign.v1.CampaignUpdateR\x0ecampaignUpdate\"-\n" +
+	"\x1bDeleteCampaignUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x1cDeleteCampaignUpdateResponse\x12?\n" +
+	"\x0fdelete_response\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x0edeleteResponse*\x84\x02\n" +
 	"\x0eCampaignStatus\x12\x1f\n" +
 	"\x1bCAMPAIGN_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16CAMPAIGN_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
 	"\x16CAMPAIGN_STATUS_PAUSED\x10\x02\x12\x1d\n" +
 	"\x19CAMPAIGN_STATUS_COMPLETED\x10\x03\x12\x1d\n" +
-	"\x19CAMPAIGN_STATUS_CANCELLED\x10\x04*\xd8\x02\n" +
+	"\x19CAMPAIGN_STATUS_CANCELLED\x10\x04\x12\x19\n" +
+	"\x15CAMPAIGN_STATUS_DRAFT\x10\x05\x12\"\n" +
+	"\x1eCAMPAIGN_STATUS_PENDING_REVIEW\x10\x06\x12\x1c\n" +
+	"\x18CAMPAIGN_STATUS_REJECTED\x10\a*\xd8\x02\n" +
 	"\x10CampaignCategory\x12!\n" +
 	"\x1dCAMPAIGN_CATEGORY_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bCAMPAIGN_CATEGORY_EDUCATION\x10\x01\x12 \n" +
@@ -908,13 +3720,38 @@ const file_campaign_v1_campaign_proto_rawDesc = "" +
 	"\x1bCAMPAIGN_CATEGORY_COMMUNITY\x10\x06\x12 \n" +
 	"\x1cCAMPAIGN_CATEGORY_TECHNOLOGY\x10\a\x12\x1a\n" +
 	"\x16CAMPAIGN_CATEGORY_ARTS\x10\b\x12\x1c\n" +
-	"\x18CAMPAIGN_CATEGORY_SPORTS\x10\t2\x85\x04\n" +
+	"\x18CAMPAIGN_CATEGORY_SPORTS\x10\t*\xd0\x01\n" +
+	"\x11CampaignSortField\x12#\n" +
+	"\x1fCAMPAIGN_SORT_FIELD_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCAMPAIGN_SORT_FIELD_CREATED_AT\x10\x01\x12 \n" +
+	"\x1cCAMPAIGN_SORT_FIELD_DEADLINE\x10\x02\x12(\n" +
+	"$CAMPAIGN_SORT_FIELD_COLLECTED_AMOUNT\x10\x03\x12&\n" +
+	"\"CAMPAIGN_SORT_FIELD_PERCENT_FUNDED\x10\x042\xd5\x12\n" +
 	"\x0fCampaignService\x12Y\n" +
 	"\x0eCreateCampaign\x12\".campaign.v1.CreateCampaignRequest\x1a#.campaign.v1.CreateCampaignResponse\x12\\\n" +
 	"\x0fGetCampaignByID\x12#.campaign.v1.GetCampaignByIDRequest\x1a$.campaign.v1.GetCampaignByIDResponse\x12e\n" +
 	"\x12DeleteCampaignByID\x12&.campaign.v1.DeleteCampaignByIDRequest\x1a'.campaign.v1.DeleteCampaignByIDResponse\x12e\n" +
 	"\x12UpdateCampaignByID\x12&.campaign.v1.UpdateCampaignByIDRequest\x1a'.campaign.v1.UpdateCampaignByIDResponse\x12k\n" +
-	"\x14GetCampaignsByUserID\x12(.campaign.v1.GetCampaignsByUserIDRequest\x1a).campaign.v1.GetCampaignsByUserIDResponseB\x14Z\x12/campaign;campaignb\x06proto3"
+	"\x14GetCampaignsByUserID\x12(.campaign.v1.GetCampaignsByUserIDRequest\x1a).campaign.v1.GetCampaignsByUserIDResponse\x12V\n" +
+	"\rListCampaigns\x12!.campaign.v1.ListCampaignsRequest\x1a\".campaign.v1.ListCampaignsResponse\x12\\\n" +
+	"\x0fSearchCampaigns\x12#.campaign.v1.SearchCampaignsRequest\x1a$.campaign.v1.SearchCampaignsResponse\x12e\n" +
+	"\x12RecordContribution\x12&.campaign.v1.RecordContributionRequest\x1a'.campaign.v1.RecordContributionResponse\x12h\n" +
+	"\x13ReverseContribution\x12'.campaign.v1.ReverseContributionRequest\x1a(.campaign.v1.ReverseContributionResponse\x12b\n" +
+	"\x11ReconcileCampaign\x12%.campaign.v1.ReconcileCampaignRequest\x1a&.campaign.v1.ReconcileCampaignResponse\x12V\n" +
+	"\rPauseCampaign\x12!.campaign.v1.PauseCampaignRequest\x1a\".campaign.v1.PauseCampaignResponse\x12Y\n" +
+	"\x0eResumeCampaign\x12\".campaign.v1.ResumeCampaignRequest\x1a#.campaign.v1.ResumeCampaignResponse\x12Y\n" +
+	"\x0eCancelCampaign\x12\".campaign.v1.CancelCampaignRequest\x1a#.campaign.v1.CancelCampaignResponse\x12a\n" +
+	"\x0fPublishCampaign\x12#.campaign.v1.PublishCampaignRequest\x1a$.campaign.v1.PublishCampaignResponse\"\x03\x88\x02\x01\x12\\\n" +
+	"\x0fSubmitForReview\x12#.campaign.v1.SubmitForReviewRequest\x1a$.campaign.v1.SubmitForReviewResponse\x12\\\n" +
+	"\x0fApproveCampaign\x12#.campaign.v1.ApproveCampaignRequest\x1a$.campaign.v1.ApproveCampaignResponse\x12Y\n" +
+	"\x0eRejectCampaign\x12\".campaign.v1.RejectCampaignRequest\x1a#.campaign.v1.RejectCampaignResponse\x12e\n" +
+	"\x12ListPendingReviews\x12&.campaign.v1.ListPendingReviewsRequest\x1a'.campaign.v1.ListPendingReviewsResponse\x12h\n" +
+	"\x13ForceCancelCampaign\x12'.campaign.v1.ForceCancelCampaignRequest\x1a(.campaign.v1.ForceCancelCampaignResponse\x12_\n" +
+	"\x10ListAllCampaigns\x12$.campaign.v1.ListAllCampaignsRequest\x1a%.campaign.v1.ListAllCampaignsResponse\x12k\n" +
+	"\x14CreateCampaignUpdate\x12(.campaign.v1.CreateCampaignUpdateRequest\x1a).campaign.v1.CreateCampaignUpdateResponse\x12h\n" +
+	"\x13ListCampaignUpdates\x12'.campaign.v1.ListCampaignUpdatesRequest\x1a(.campaign.v1.ListCampaignUpdatesResponse\x12e\n" +
+	"\x12EditCampaignUpdate\x12&.campaign.v1.EditCampaignUpdateRequest\x1a'.campaign.v1.EditCampaignUpdateResponse\x12k\n" +
+	"\x14DeleteCampaignUpdate\x12(.campaign.v1.DeleteCampaignUpdateRequest\x1a).campaign.v1.DeleteCampaignUpdateResponseB\x14Z\x12/campaign;campaignb\x06proto3"
 
 var (
 	file_campaign_v1_campaign_proto_rawDescOnce sync.Once
@@ -928,56 +3765,183 @@ func file_campaign_v1_campaign_proto_rawDescGZIP() []byte {
 	return file_campaign_v1_campaign_proto_rawDescData
 }
 
-var file_campaign_v1_campaign_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_campaign_v1_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_campaign_v1_campaign_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_campaign_v1_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_campaign_v1_campaign_proto_goTypes = []any{
 	(CampaignStatus)(0),                  // 0: campaign.v1.CampaignStatus
 	(CampaignCategory)(0),                // 1: campaign.v1.CampaignCategory
-	(*Campaign)(nil),                     // 2: campaign.v1.Campaign
-	(*CreateCampaignRequest)(nil),        // 3: campaign.v1.CreateCampaignRequest
-	(*CreateCampaignResponse)(nil),       // 4: campaign.v1.CreateCampaignResponse
-	(*GetCampaignByIDRequest)(nil),       // 5: campaign.v1.GetCampaignByIDRequest
-	(*GetCampaignByIDResponse)(nil),      // 6: campaign.v1.GetCampaignByIDResponse
-	(*DeleteCampaignByIDRequest)(nil),    // 7: campaign.v1.DeleteCampaignByIDRequest
-	(*DeleteCampaignByIDResponse)(nil),   // 8: campaign.v1.DeleteCampaignByIDResponse
-	(*UpdateCampaignByIDRequest)(nil),    // 9: campaign.v1.UpdateCampaignByIDRequest
-	(*UpdateCampaignByIDResponse)(nil),   // 10: campaign.v1.UpdateCampaignByIDResponse
-	(*GetCampaignsByUserIDRequest)(nil),  // 11: campaign.v1.GetCampaignsByUserIDRequest
-	(*GetCampaignsByUserIDResponse)(nil), // 12: campaign.v1.GetCampaignsByUserIDResponse
-	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                // 14: google.protobuf.Empty
+	(CampaignSortField)(0),               // 2: campaign.v1.CampaignSortField
+	(*Money)(nil),                        // 3: campaign.v1.Money
+	(*Campaign)(nil),                     // 4: campaign.v1.Campaign
+	(*CreateCampaignRequest)(nil),        // 5: campaign.v1.CreateCampaignRequest
+	(*CreateCampaignResponse)(nil),       // 6: campaign.v1.CreateCampaignResponse
+	(*GetCampaignByIDRequest)(nil),       // 7: campaign.v1.GetCampaignByIDRequest
+	(*GetCampaignByIDResponse)(nil),      // 8: campaign.v1.GetCampaignByIDResponse
+	(*DeleteCampaignByIDRequest)(nil),    // 9: campaign.v1.DeleteCampaignByIDRequest
+	(*DeleteCampaignByIDResponse)(nil),   // 10: campaign.v1.DeleteCampaignByIDResponse
+	(*UpdateCampaignByIDRequest)(nil),    // 11: campaign.v1.UpdateCampaignByIDRequest
+	(*UpdateCampaignByIDResponse)(nil),   // 12: campaign.v1.UpdateCampaignByIDResponse
+	(*GetCampaignsByUserIDRequest)(nil),  // 13: campaign.v1.GetCampaignsByUserIDRequest
+	(*GetCampaignsByUserIDResponse)(nil), // 14: campaign.v1.GetCampaignsByUserIDResponse
+	(*ListCampaignsRequest)(nil),         // 15: campaign.v1.ListCampaignsRequest
+	(*ListCampaignsResponse)(nil),        // 16: campaign.v1.ListCampaignsResponse
+	(*SearchCampaignsRequest)(nil),       // 17: campaign.v1.SearchCampaignsRequest
+	(*SearchCampaignResult)(nil),         // 18: campaign.v1.SearchCampaignResult
+	(*SearchCampaignsResponse)(nil),      // 19: campaign.v1.SearchCampaignsResponse
+	(*RecordContributionRequest)(nil),    // 20: campaign.v1.RecordContributionRequest
+	(*RecordContributionResponse)(nil),   // 21: campaign.v1.RecordContributionResponse
+	(*ReverseContributionRequest)(nil),   // 22: campaign.v1.ReverseContributionRequest
+	(*ReverseContributionResponse)(nil),  // 23: campaign.v1.ReverseContributionResponse
+	(*ReconcileCampaignRequest)(nil),     // 24: campaign.v1.ReconcileCampaignRequest
+	(*ReconcileCampaignResponse)(nil),    // 25: campaign.v1.ReconcileCampaignResponse
+	(*PauseCampaignRequest)(nil),         // 26: campaign.v1.PauseCampaignRequest
+	(*PauseCampaignResponse)(nil),        // 27: campaign.v1.PauseCampaignResponse
+	(*ResumeCampaignRequest)(nil),        // 28: campaign.v1.ResumeCampaignRequest
+	(*ResumeCampaignResponse)(nil),       // 29: campaign.v1.ResumeCampaignResponse
+	(*CancelCampaignRequest)(nil),        // 30: campaign.v1.CancelCampaignRequest
+	(*CancelCampaignResponse)(nil),       // 31: campaign.v1.CancelCampaignResponse
+	(*PublishCampaignRequest)(nil),       // 32: campaign.v1.PublishCampaignRequest
+	(*PublishCampaignResponse)(nil),      // 33: campaign.v1.PublishCampaignResponse
+	(*SubmitForReviewRequest)(nil),       // 34: campaign.v1.SubmitForReviewRequest
+	(*SubmitForReviewResponse)(nil),      // 35: campaign.v1.SubmitForReviewResponse
+	(*ApproveCampaignRequest)(nil),       // 36: campaign.v1.ApproveCampaignRequest
+	(*ApproveCampaignResponse)(nil),      // 37: campaign.v1.ApproveCampaignResponse
+	(*RejectCampaignRequest)(nil),        // 38: campaign.v1.RejectCampaignRequest
+	(*RejectCampaignResponse)(nil),       // 39: campaign.v1.RejectCampaignResponse
+	(*ListPendingReviewsRequest)(nil),    // 40: campaign.v1.ListPendingReviewsRequest
+	(*ListPendingReviewsResponse)(nil),   // 41: campaign.v1.ListPendingReviewsResponse
+	(*ForceCancelCampaignRequest)(nil),   // 42: campaign.v1.ForceCancelCampaignRequest
+	(*ForceCancelCampaignResponse)(nil),  // 43: campaign.v1.ForceCancelCampaignResponse
+	(*ListAllCampaignsRequest)(nil),      // 44: campaign.v1.ListAllCampaignsRequest
+	(*ListAllCampaignsResponse)(nil),     // 45: campaign.v1.ListAllCampaignsResponse
+	(*CampaignUpdate)(nil),               // 46: campaign.v1.CampaignUpdate
+	(*CreateCampaignUpdateRequest)(nil),  // 47: campaign.v1.CreateCampaignUpdateRequest
+	(*CreateCampaignUpdateResponse)(nil), // 48: campaign.v1.CreateCampaignUpdateResponse
+	(*ListCampaignUpdatesRequest)(nil),   // 49: campaign.v1.ListCampaignUpdatesRequest
+	(*ListCampaignUpdatesResponse)(nil),  // 50: campaign.v1.ListCampaignUpdatesResponse
+	(*EditCampaignUpdateRequest)(nil),    // 51: campaign.v1.EditCampaignUpdateRequest
+	(*EditCampaignUpdateResponse)(nil),   // 52: campaign.v1.EditCampaignUpdateResponse
+	(*DeleteCampaignUpdateRequest)(nil),  // 53: campaign.v1.DeleteCampaignUpdateRequest
+	(*DeleteCampaignUpdateResponse)(nil), // 54: campaign.v1.DeleteCampaignUpdateResponse
+	(*timestamppb.Timestamp)(nil),        // 55: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 56: google.protobuf.Empty
+	(*fieldmaskpb.FieldMask)(nil),        // 57: google.protobuf.FieldMask
 }
 var file_campaign_v1_campaign_proto_depIdxs = []int32{
-	13, // 0: campaign.v1.Campaign.deadline:type_name -> google.protobuf.Timestamp
-	0,  // 1: campaign.v1.Campaign.status:type_name -> campaign.v1.CampaignStatus
-	1,  // 2: campaign.v1.Campaign.category:type_name -> campaign.v1.CampaignCategory
-	13, // 3: campaign.v1.Campaign.created_at:type_name -> google.protobuf.Timestamp
-	13, // 4: campaign.v1.Campaign.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 5: campaign.v1.CreateCampaignRequest.deadline:type_name -> google.protobuf.Timestamp
-	1,  // 6: campaign.v1.CreateCampaignRequest.category:type_name -> campaign.v1.CampaignCategory
-	2,  // 7: campaign.v1.CreateCampaignResponse.created_campaign:type_name -> campaign.v1.Campaign
-	2,  // 8: campaign.v1.GetCampaignByIDResponse.campaign:type_name -> campaign.v1.Campaign
-	14, // 9: campaign.v1.DeleteCampaignByIDResponse.delete_response:type_name -> google.protobuf.Empty
-	13, // 10: campaign.v1.UpdateCampaignByIDRequest.deadline:type_name -> google.protobuf.Timestamp
-	0,  // 11: campaign.v1.UpdateCampaignByIDRequest.status:type_name -> campaign.v1.CampaignStatus
-	1,  // 12: campaign.v1.UpdateCampaignByIDRequest.category:type_name -> campaign.v1.CampaignCategory
-	2,  // 13: campaign.v1.UpdateCampaignByIDResponse.updated_campaign:type_name -> campaign.v1.Campaign
-	2,  // 14: campaign.v1.GetCampaignsByUserIDResponse.campaign:type_name -> campaign.v1.Campaign
-	3,  // 15: campaign.v1.CampaignService.CreateCampaign:input_type -> campaign.v1.CreateCampaignRequest
-	5,  // 16: campaign.v1.CampaignService.GetCampaignByID:input_type -> campaign.v1.GetCampaignByIDRequest
-	7,  // 17: campaign.v1.CampaignService.DeleteCampaignByID:input_type -> campaign.v1.DeleteCampaignByIDRequest
-	9,  // 18: campaign.v1.CampaignService.UpdateCampaignByID:input_type -> campaign.v1.UpdateCampaignByIDRequest
-	11, // 19: campaign.v1.CampaignService.GetCampaignsByUserID:input_type -> campaign.v1.GetCampaignsByUserIDRequest
-	4,  // 20: campaign.v1.CampaignService.CreateCampaign:output_type -> campaign.v1.CreateCampaignResponse
-	6,  // 21: campaign.v1.CampaignService.GetCampaignByID:output_type -> campaign.v1.GetCampaignByIDResponse
-	8,  // 22: campaign.v1.CampaignService.DeleteCampaignByID:output_type -> campaign.v1.DeleteCampaignByIDResponse
-	10, // 23: campaign.v1.CampaignService.UpdateCampaignByID:output_type -> campaign.v1.UpdateCampaignByIDResponse
-	12, // 24: campaign.v1.CampaignService.GetCampaignsByUserID:output_type -> campaign.v1.GetCampaignsByUserIDResponse
-	20, // [20:25] is the sub-list for method output_type
-	15, // [15:20] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	3,  // 0: campaign.v1.Campaign.target_amount:type_name -> campaign.v1.Money
+	3,  // 1: campaign.v1.Campaign.collected_amount:type_name -> campaign.v1.Money
+	55, // 2: campaign.v1.Campaign.deadline:type_name -> google.protobuf.Timestamp
+	0,  // 3: campaign.v1.Campaign.status:type_name -> campaign.v1.CampaignStatus
+	1,  // 4: campaign.v1.Campaign.category:type_name -> campaign.v1.CampaignCategory
+	3,  // 5: campaign.v1.Campaign.min_donation:type_name -> campaign.v1.Money
+	55, // 6: campaign.v1.Campaign.created_at:type_name -> google.protobuf.Timestamp
+	55, // 7: campaign.v1.Campaign.updated_at:type_name -> google.protobuf.Timestamp
+	55, // 8: campaign.v1.Campaign.deleted_at:type_name -> google.protobuf.Timestamp
+	3,  // 9: campaign.v1.CreateCampaignRequest.target_amount:type_name -> campaign.v1.Money
+	55, // 10: campaign.v1.CreateCampaignRequest.deadline:type_name -> google.protobuf.Timestamp
+	1,  // 11: campaign.v1.CreateCampaignRequest.category:type_name -> campaign.v1.CampaignCategory
+	3,  // 12: campaign.v1.CreateCampaignRequest.min_donation:type_name -> campaign.v1.Money
+	4,  // 13: campaign.v1.CreateCampaignResponse.created_campaign:type_name -> campaign.v1.Campaign
+	4,  // 14: campaign.v1.GetCampaignByIDResponse.campaign:type_name -> campaign.v1.Campaign
+	56, // 15: campaign.v1.DeleteCampaignByIDResponse.delete_response:type_name -> google.protobuf.Empty
+	3,  // 16: campaign.v1.UpdateCampaignByIDRequest.target_amount:type_name -> campaign.v1.Money
+	55, // 17: campaign.v1.UpdateCampaignByIDRequest.deadline:type_name -> google.protobuf.Timestamp
+	0,  // 18: campaign.v1.UpdateCampaignByIDRequest.status:type_name -> campaign.v1.CampaignStatus
+	1,  // 19: campaign.v1.UpdateCampaignByIDRequest.category:type_name -> campaign.v1.CampaignCategory
+	3,  // 20: campaign.v1.UpdateCampaignByIDRequest.min_donation:type_name -> campaign.v1.Money
+	57, // 21: campaign.v1.UpdateCampaignByIDRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 22: campaign.v1.UpdateCampaignByIDResponse.updated_campaign:type_name -> campaign.v1.Campaign
+	0,  // 23: campaign.v1.GetCampaignsByUserIDRequest.statuses:type_name -> campaign.v1.CampaignStatus
+	4,  // 24: campaign.v1.GetCampaignsByUserIDResponse.campaign:type_name -> campaign.v1.Campaign
+	0,  // 25: campaign.v1.ListCampaignsRequest.statuses:type_name -> campaign.v1.CampaignStatus
+	1,  // 26: campaign.v1.ListCampaignsRequest.categories:type_name -> campaign.v1.CampaignCategory
+	55, // 27: campaign.v1.ListCampaignsRequest.deadline_from:type_name -> google.protobuf.Timestamp
+	55, // 28: campaign.v1.ListCampaignsRequest.deadline_to:type_name -> google.protobuf.Timestamp
+	2,  // 29: campaign.v1.ListCampaignsRequest.sort_by:type_name -> campaign.v1.CampaignSortField
+	4,  // 30: campaign.v1.ListCampaignsResponse.campaign:type_name -> campaign.v1.Campaign
+	0,  // 31: campaign.v1.SearchCampaignsRequest.statuses:type_name -> campaign.v1.CampaignStatus
+	1,  // 32: campaign.v1.SearchCampaignsRequest.categories:type_name -> campaign.v1.CampaignCategory
+	4,  // 33: campaign.v1.SearchCampaignResult.campaign:type_name -> campaign.v1.Campaign
+	18, // 34: campaign.v1.SearchCampaignsResponse.result:type_name -> campaign.v1.SearchCampaignResult
+	3,  // 35: campaign.v1.RecordContributionRequest.amount:type_name -> campaign.v1.Money
+	3,  // 36: campaign.v1.RecordContributionResponse.collected_amount:type_name -> campaign.v1.Money
+	4,  // 37: campaign.v1.RecordContributionResponse.campaign:type_name -> campaign.v1.Campaign
+	3,  // 38: campaign.v1.ReverseContributionRequest.amount:type_name -> campaign.v1.Money
+	3,  // 39: campaign.v1.ReverseContributionResponse.collected_amount:type_name -> campaign.v1.Money
+	4,  // 40: campaign.v1.ReverseContributionResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 41: campaign.v1.ReconcileCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	3,  // 42: campaign.v1.ReconcileCampaignResponse.ledger_amount:type_name -> campaign.v1.Money
+	3,  // 43: campaign.v1.ReconcileCampaignResponse.drift:type_name -> campaign.v1.Money
+	4,  // 44: campaign.v1.PauseCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 45: campaign.v1.ResumeCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 46: campaign.v1.CancelCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 47: campaign.v1.PublishCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 48: campaign.v1.SubmitForReviewResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 49: campaign.v1.ApproveCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 50: campaign.v1.RejectCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 51: campaign.v1.ListPendingReviewsResponse.campaign:type_name -> campaign.v1.Campaign
+	4,  // 52: campaign.v1.ForceCancelCampaignResponse.campaign:type_name -> campaign.v1.Campaign
+	0,  // 53: campaign.v1.ListAllCampaignsRequest.statuses:type_name -> campaign.v1.CampaignStatus
+	4,  // 54: campaign.v1.ListAllCampaignsResponse.campaign:type_name -> campaign.v1.Campaign
+	55, // 55: campaign.v1.CampaignUpdate.created_at:type_name -> google.protobuf.Timestamp
+	55, // 56: campaign.v1.CampaignUpdate.updated_at:type_name -> google.protobuf.Timestamp
+	46, // 57: campaign.v1.CreateCampaignUpdateResponse.campaign_update:type_name -> campaign.v1.CampaignUpdate
+	46, // 58: campaign.v1.ListCampaignUpdatesResponse.campaign_update:type_name -> campaign.v1.CampaignUpdate
+	46, // 59: campaign.v1.EditCampaignUpdateResponse.campaign_update:type_name -> campaign.v1.CampaignUpdate
+	56, // 60: campaign.v1.DeleteCampaignUpdateResponse.delete_response:type_name -> google.protobuf.Empty
+	5,  // 61: campaign.v1.CampaignService.CreateCampaign:input_type -> campaign.v1.CreateCampaignRequest
+	7,  // 62: campaign.v1.CampaignService.GetCampaignByID:input_type -> campaign.v1.GetCampaignByIDRequest
+	9,  // 63: campaign.v1.CampaignService.DeleteCampaignByID:input_type -> campaign.v1.DeleteCampaignByIDRequest
+	11, // 64: campaign.v1.CampaignService.UpdateCampaignByID:input_type -> campaign.v1.UpdateCampaignByIDRequest
+	13, // 65: campaign.v1.CampaignService.GetCampaignsByUserID:input_type -> campaign.v1.GetCampaignsByUserIDRequest
+	15, // 66: campaign.v1.CampaignService.ListCampaigns:input_type -> campaign.v1.ListCampaignsRequest
+	17, // 67: campaign.v1.CampaignService.SearchCampaigns:input_type -> campaign.v1.SearchCampaignsRequest
+	20, // 68: campaign.v1.CampaignService.RecordContribution:input_type -> campaign.v1.RecordContributionRequest
+	22, // 69: campaign.v1.CampaignService.ReverseContribution:input_type -> campaign.v1.ReverseContributionRequest
+	24, // 70: campaign.v1.CampaignService.ReconcileCampaign:input_type -> campaign.v1.ReconcileCampaignRequest
+	26, // 71: campaign.v1.CampaignService.PauseCampaign:input_type -> campaign.v1.PauseCampaignRequest
+	28, // 72: campaign.v1.CampaignService.ResumeCampaign:input_type -> campaign.v1.ResumeCampaignRequest
+	30, // 73: campaign.v1.CampaignService.CancelCampaign:input_type -> campaign.v1.CancelCampaignRequest
+	32, // 74: campaign.v1.CampaignService.PublishCampaign:input_type -> campaign.v1.PublishCampaignRequest
+	34, // 75: campaign.v1.CampaignService.SubmitForReview:input_type -> campaign.v1.SubmitForReviewRequest
+	36, // 76: campaign.v1.CampaignService.ApproveCampaign:input_type -> campaign.v1.ApproveCampaignRequest
+	38, // 77: campaign.v1.CampaignService.RejectCampaign:input_type -> campaign.v1.RejectCampaignRequest
+	40, // 78: campaign.v1.CampaignService.ListPendingReviews:input_type -> campaign.v1.ListPendingReviewsRequest
+	42, // 79: campaign.v1.CampaignService.ForceCancelCampaign:input_type -> campaign.v1.ForceCancelCampaignRequest
+	44, // 80: campaign.v1.CampaignService.ListAllCampaigns:input_type -> campaign.v1.ListAllCampaignsRequest
+	47, // 81: campaign.v1.CampaignService.CreateCampaignUpdate:input_type -> campaign.v1.CreateCampaignUpdateRequest
+	49, // 82: campaign.v1.CampaignService.ListCampaignUpdates:input_type -> campaign.v1.ListCampaignUpdatesRequest
+	51, // 83: campaign.v1.CampaignService.EditCampaignUpdate:input_type -> campaign.v1.EditCampaignUpdateRequest
+	53, // 84: campaign.v1.CampaignService.DeleteCampaignUpdate:input_type -> campaign.v1.DeleteCampaignUpdateRequest
+	6,  // 85: campaign.v1.CampaignService.CreateCampaign:output_type -> campaign.v1.CreateCampaignResponse
+	8,  // 86: campaign.v1.CampaignService.GetCampaignByID:output_type -> campaign.v1.GetCampaignByIDResponse
+	10, // 87: campaign.v1.CampaignService.DeleteCampaignByID:output_type -> campaign.v1.DeleteCampaignByIDResponse
+	12, // 88: campaign.v1.CampaignService.UpdateCampaignByID:output_type -> campaign.v1.UpdateCampaignByIDResponse
+	14, // 89: campaign.v1.CampaignService.GetCampaignsByUserID:output_type -> campaign.v1.GetCampaignsByUserIDResponse
+	16, // 90: campaign.v1.CampaignService.ListCampaigns:output_type -> campaign.v1.ListCampaignsResponse
+	19, // 91: campaign.v1.CampaignService.SearchCampaigns:output_type -> campaign.v1.SearchCampaignsResponse
+	21, // 92: campaign.v1.CampaignService.RecordContribution:output_type -> campaign.v1.RecordContributionResponse
+	23, // 93: campaign.v1.CampaignService.ReverseContribution:output_type -> campaign.v1.ReverseContributionResponse
+	25, // 94: campaign.v1.CampaignService.ReconcileCampaign:output_type -> campaign.v1.ReconcileCampaignResponse
+	27, // 95: campaign.v1.CampaignService.PauseCampaign:output_type -> campaign.v1.PauseCampaignResponse
+	29, // 96: campaign.v1.CampaignService.ResumeCampaign:output_type -> campaign.v1.ResumeCampaignResponse
+	31, // 97: campaign.v1.CampaignService.CancelCampaign:output_type -> campaign.v1.CancelCampaignResponse
+	33, // 98: campaign.v1.CampaignService.PublishCampaign:output_type -> campaign.v1.PublishCampaignResponse
+	35, // 99: campaign.v1.CampaignService.SubmitForReview:output_type -> campaign.v1.SubmitForReviewResponse
+	37, // 100: campaign.v1.CampaignService.ApproveCampaign:output_type -> campaign.v1.ApproveCampaignResponse
+	39, // 101: campaign.v1.CampaignService.RejectCampaign:output_type -> campaign.v1.RejectCampaignResponse
+	41, // 102: campaign.v1.CampaignService.ListPendingReviews:output_type -> campaign.v1.ListPendingReviewsResponse
+	43, // 103: campaign.v1.CampaignService.ForceCancelCampaign:output_type -> campaign.v1.ForceCancelCampaignResponse
+	45, // 104: campaign.v1.CampaignService.ListAllCampaigns:output_type -> campaign.v1.ListAllCampaignsResponse
+	48, // 105: campaign.v1.CampaignService.CreateCampaignUpdate:output_type -> campaign.v1.CreateCampaignUpdateResponse
+	50, // 106: campaign.v1.CampaignService.ListCampaignUpdates:output_type -> campaign.v1.ListCampaignUpdatesResponse
+	52, // 107: campaign.v1.CampaignService.EditCampaignUpdate:output_type -> campaign.v1.EditCampaignUpdateResponse
+	54, // 108: campaign.v1.CampaignService.DeleteCampaignUpdate:output_type -> campaign.v1.DeleteCampaignUpdateResponse
+	85, // [85:109] is the sub-list for method output_type
+	61, // [61:85] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_campaign_v1_campaign_proto_init() }
@@ -985,13 +3949,14 @@ func file_campaign_v1_campaign_proto_init() {
 	if File_campaign_v1_campaign_proto != nil {
 		return
 	}
+	file_campaign_v1_campaign_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_campaign_v1_campaign_proto_rawDesc), len(file_campaign_v1_campaign_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
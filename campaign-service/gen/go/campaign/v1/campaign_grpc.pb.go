@@ -24,6 +24,25 @@ const (
 	CampaignService_DeleteCampaignByID_FullMethodName   = "/campaign.v1.CampaignService/DeleteCampaignByID"
 	CampaignService_UpdateCampaignByID_FullMethodName   = "/campaign.v1.CampaignService/UpdateCampaignByID"
 	CampaignService_GetCampaignsByUserID_FullMethodName = "/campaign.v1.CampaignService/GetCampaignsByUserID"
+	CampaignService_ListCampaigns_FullMethodName        = "/campaign.v1.CampaignService/ListCampaigns"
+	CampaignService_SearchCampaigns_FullMethodName      = "/campaign.v1.CampaignService/SearchCampaigns"
+	CampaignService_RecordContribution_FullMethodName   = "/campaign.v1.CampaignService/RecordContribution"
+	CampaignService_ReverseContribution_FullMethodName  = "/campaign.v1.CampaignService/ReverseContribution"
+	CampaignService_ReconcileCampaign_FullMethodName    = "/campaign.v1.CampaignService/ReconcileCampaign"
+	CampaignService_PauseCampaign_FullMethodName        = "/campaign.v1.CampaignService/PauseCampaign"
+	CampaignService_ResumeCampaign_FullMethodName       = "/campaign.v1.CampaignService/ResumeCampaign"
+	CampaignService_CancelCampaign_FullMethodName       = "/campaign.v1.CampaignService/CancelCampaign"
+	CampaignService_PublishCampaign_FullMethodName      = "/campaign.v1.CampaignService/PublishCampaign"
+	CampaignService_SubmitForReview_FullMethodName      = "/campaign.v1.CampaignService/SubmitForReview"
+	CampaignService_ApproveCampaign_FullMethodName      = "/campaign.v1.CampaignService/ApproveCampaign"
+	CampaignService_RejectCampaign_FullMethodName       = "/campaign.v1.CampaignService/RejectCampaign"
+	CampaignService_ListPendingReviews_FullMethodName   = "/campaign.v1.CampaignService/ListPendingReviews"
+	CampaignService_ForceCancelCampaign_FullMethodName  = "/campaign.v1.CampaignService/ForceCancelCampaign"
+	CampaignService_ListAllCampaigns_FullMethodName     = "/campaign.v1.CampaignService/ListAllCampaigns"
+	CampaignService_CreateCampaignUpdate_FullMethodName = "/campaign.v1.CampaignService/CreateCampaignUpdate"
+	CampaignService_ListCampaignUpdates_FullMethodName  = "/campaign.v1.CampaignService/ListCampaignUpdates"
+	CampaignService_EditCampaignUpdate_FullMethodName   = "/campaign.v1.CampaignService/EditCampaignUpdate"
+	CampaignService_DeleteCampaignUpdate_FullMethodName = "/campaign.v1.CampaignService/DeleteCampaignUpdate"
 )
 
 // CampaignServiceClient is the client API for CampaignService service.
@@ -35,6 +54,26 @@ type CampaignServiceClient interface {
 	DeleteCampaignByID(ctx context.Context, in *DeleteCampaignByIDRequest, opts ...grpc.CallOption) (*DeleteCampaignByIDResponse, error)
 	UpdateCampaignByID(ctx context.Context, in *UpdateCampaignByIDRequest, opts ...grpc.CallOption) (*UpdateCampaignByIDResponse, error)
 	GetCampaignsByUserID(ctx context.Context, in *GetCampaignsByUserIDRequest, opts ...grpc.CallOption) (*GetCampaignsByUserIDResponse, error)
+	ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error)
+	SearchCampaigns(ctx context.Context, in *SearchCampaignsRequest, opts ...grpc.CallOption) (*SearchCampaignsResponse, error)
+	RecordContribution(ctx context.Context, in *RecordContributionRequest, opts ...grpc.CallOption) (*RecordContributionResponse, error)
+	ReverseContribution(ctx context.Context, in *ReverseContributionRequest, opts ...grpc.CallOption) (*ReverseContributionResponse, error)
+	ReconcileCampaign(ctx context.Context, in *ReconcileCampaignRequest, opts ...grpc.CallOption) (*ReconcileCampaignResponse, error)
+	PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*PauseCampaignResponse, error)
+	ResumeCampaign(ctx context.Context, in *ResumeCampaignRequest, opts ...grpc.CallOption) (*ResumeCampaignResponse, error)
+	CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*CancelCampaignResponse, error)
+	// Deprecated: Do not use.
+	PublishCampaign(ctx context.Context, in *PublishCampaignRequest, opts ...grpc.CallOption) (*PublishCampaignResponse, error)
+	SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewResponse, error)
+	ApproveCampaign(ctx context.Context, in *ApproveCampaignRequest, opts ...grpc.CallOption) (*ApproveCampaignResponse, error)
+	RejectCampaign(ctx context.Context, in *RejectCampaignRequest, opts ...grpc.CallOption) (*RejectCampaignResponse, error)
+	ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsResponse, error)
+	ForceCancelCampaign(ctx context.Context, in *ForceCancelCampaignRequest, opts ...grpc.CallOption) (*ForceCancelCampaignResponse, error)
+	ListAllCampaigns(ctx context.Context, in *ListAllCampaignsRequest, opts ...grpc.CallOption) (*ListAllCampaignsResponse, error)
+	CreateCampaignUpdate(ctx context.Context, in *CreateCampaignUpdateRequest, opts ...grpc.CallOption) (*CreateCampaignUpdateResponse, error)
+	ListCampaignUpdates(ctx context.Context, in *ListCampaignUpdatesRequest, opts ...grpc.CallOption) (*ListCampaignUpdatesResponse, error)
+	EditCampaignUpdate(ctx context.Context, in *EditCampaignUpdateRequest, opts ...grpc.CallOption) (*EditCampaignUpdateResponse, error)
+	DeleteCampaignUpdate(ctx context.Context, in *DeleteCampaignUpdateRequest, opts ...grpc.CallOption) (*DeleteCampaignUpdateResponse, error)
 }
 
 type campaignServiceClient struct {
@@ -95,6 +134,197 @@ func (c *campaignServiceClient) GetCampaignsByUserID(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *campaignServiceClient) ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignsResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ListCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) SearchCampaigns(ctx context.Context, in *SearchCampaignsRequest, opts ...grpc.CallOption) (*SearchCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCampaignsResponse)
+	err := c.cc.Invoke(ctx, CampaignService_SearchCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) RecordContribution(ctx context.Context, in *RecordContributionRequest, opts ...grpc.CallOption) (*RecordContributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordContributionResponse)
+	err := c.cc.Invoke(ctx, CampaignService_RecordContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ReverseContribution(ctx context.Context, in *ReverseContributionRequest, opts ...grpc.CallOption) (*ReverseContributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseContributionResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ReverseContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ReconcileCampaign(ctx context.Context, in *ReconcileCampaignRequest, opts ...grpc.CallOption) (*ReconcileCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ReconcileCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*PauseCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_PauseCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ResumeCampaign(ctx context.Context, in *ResumeCampaignRequest, opts ...grpc.CallOption) (*ResumeCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ResumeCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*CancelCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_CancelCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *campaignServiceClient) PublishCampaign(ctx context.Context, in *PublishCampaignRequest, opts ...grpc.CallOption) (*PublishCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_PublishCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitForReviewResponse)
+	err := c.cc.Invoke(ctx, CampaignService_SubmitForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ApproveCampaign(ctx context.Context, in *ApproveCampaignRequest, opts ...grpc.CallOption) (*ApproveCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ApproveCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) RejectCampaign(ctx context.Context, in *RejectCampaignRequest, opts ...grpc.CallOption) (*RejectCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_RejectCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingReviewsResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ListPendingReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ForceCancelCampaign(ctx context.Context, in *ForceCancelCampaignRequest, opts ...grpc.CallOption) (*ForceCancelCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceCancelCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ForceCancelCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ListAllCampaigns(ctx context.Context, in *ListAllCampaignsRequest, opts ...grpc.CallOption) (*ListAllCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllCampaignsResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ListAllCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) CreateCampaignUpdate(ctx context.Context, in *CreateCampaignUpdateRequest, opts ...grpc.CallOption) (*CreateCampaignUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampaignUpdateResponse)
+	err := c.cc.Invoke(ctx, CampaignService_CreateCampaignUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ListCampaignUpdates(ctx context.Context, in *ListCampaignUpdatesRequest, opts ...grpc.CallOption) (*ListCampaignUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignUpdatesResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ListCampaignUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) EditCampaignUpdate(ctx context.Context, in *EditCampaignUpdateRequest, opts ...grpc.CallOption) (*EditCampaignUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCampaignUpdateResponse)
+	err := c.cc.Invoke(ctx, CampaignService_EditCampaignUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) DeleteCampaignUpdate(ctx context.Context, in *DeleteCampaignUpdateRequest, opts ...grpc.CallOption) (*DeleteCampaignUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCampaignUpdateResponse)
+	err := c.cc.Invoke(ctx, CampaignService_DeleteCampaignUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CampaignServiceServer is the server API for CampaignService service.
 // All implementations must embed UnimplementedCampaignServiceServer
 // for forward compatibility.
@@ -104,6 +334,26 @@ type CampaignServiceServer interface {
 	DeleteCampaignByID(context.Context, *DeleteCampaignByIDRequest) (*DeleteCampaignByIDResponse, error)
 	UpdateCampaignByID(context.Context, *UpdateCampaignByIDRequest) (*UpdateCampaignByIDResponse, error)
 	GetCampaignsByUserID(context.Context, *GetCampaignsByUserIDRequest) (*GetCampaignsByUserIDResponse, error)
+	ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error)
+	SearchCampaigns(context.Context, *SearchCampaignsRequest) (*SearchCampaignsResponse, error)
+	RecordContribution(context.Context, *RecordContributionRequest) (*RecordContributionResponse, error)
+	ReverseContribution(context.Context, *ReverseContributionRequest) (*ReverseContributionResponse, error)
+	ReconcileCampaign(context.Context, *ReconcileCampaignRequest) (*ReconcileCampaignResponse, error)
+	PauseCampaign(context.Context, *PauseCampaignRequest) (*PauseCampaignResponse, error)
+	ResumeCampaign(context.Context, *ResumeCampaignRequest) (*ResumeCampaignResponse, error)
+	CancelCampaign(context.Context, *CancelCampaignRequest) (*CancelCampaignResponse, error)
+	// Deprecated: Do not use.
+	PublishCampaign(context.Context, *PublishCampaignRequest) (*PublishCampaignResponse, error)
+	SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewResponse, error)
+	ApproveCampaign(context.Context, *ApproveCampaignRequest) (*ApproveCampaignResponse, error)
+	RejectCampaign(context.Context, *RejectCampaignRequest) (*RejectCampaignResponse, error)
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsResponse, error)
+	ForceCancelCampaign(context.Context, *ForceCancelCampaignRequest) (*ForceCancelCampaignResponse, error)
+	ListAllCampaigns(context.Context, *ListAllCampaignsRequest) (*ListAllCampaignsResponse, error)
+	CreateCampaignUpdate(context.Context, *CreateCampaignUpdateRequest) (*CreateCampaignUpdateResponse, error)
+	ListCampaignUpdates(context.Context, *ListCampaignUpdatesRequest) (*ListCampaignUpdatesResponse, error)
+	EditCampaignUpdate(context.Context, *EditCampaignUpdateRequest) (*EditCampaignUpdateResponse, error)
+	DeleteCampaignUpdate(context.Context, *DeleteCampaignUpdateRequest) (*DeleteCampaignUpdateResponse, error)
 	mustEmbedUnimplementedCampaignServiceServer()
 }
 
@@ -129,6 +379,63 @@ func (UnimplementedCampaignServiceServer) UpdateCampaignByID(context.Context, *U
 func (UnimplementedCampaignServiceServer) GetCampaignsByUserID(context.Context, *GetCampaignsByUserIDRequest) (*GetCampaignsByUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCampaignsByUserID not implemented")
 }
+func (UnimplementedCampaignServiceServer) ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedCampaignServiceServer) SearchCampaigns(context.Context, *SearchCampaignsRequest) (*SearchCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCampaigns not implemented")
+}
+func (UnimplementedCampaignServiceServer) RecordContribution(context.Context, *RecordContributionRequest) (*RecordContributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordContribution not implemented")
+}
+func (UnimplementedCampaignServiceServer) ReverseContribution(context.Context, *ReverseContributionRequest) (*ReverseContributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseContribution not implemented")
+}
+func (UnimplementedCampaignServiceServer) ReconcileCampaign(context.Context, *ReconcileCampaignRequest) (*ReconcileCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) PauseCampaign(context.Context, *PauseCampaignRequest) (*PauseCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) ResumeCampaign(context.Context, *ResumeCampaignRequest) (*ResumeCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) CancelCampaign(context.Context, *CancelCampaignRequest) (*CancelCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) PublishCampaign(context.Context, *PublishCampaignRequest) (*PublishCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitForReview not implemented")
+}
+func (UnimplementedCampaignServiceServer) ApproveCampaign(context.Context, *ApproveCampaignRequest) (*ApproveCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) RejectCampaign(context.Context, *RejectCampaignRequest) (*RejectCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReviews not implemented")
+}
+func (UnimplementedCampaignServiceServer) ForceCancelCampaign(context.Context, *ForceCancelCampaignRequest) (*ForceCancelCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCancelCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) ListAllCampaigns(context.Context, *ListAllCampaignsRequest) (*ListAllCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllCampaigns not implemented")
+}
+func (UnimplementedCampaignServiceServer) CreateCampaignUpdate(context.Context, *CreateCampaignUpdateRequest) (*CreateCampaignUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaignUpdate not implemented")
+}
+func (UnimplementedCampaignServiceServer) ListCampaignUpdates(context.Context, *ListCampaignUpdatesRequest) (*ListCampaignUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaignUpdates not implemented")
+}
+func (UnimplementedCampaignServiceServer) EditCampaignUpdate(context.Context, *EditCampaignUpdateRequest) (*EditCampaignUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditCampaignUpdate not implemented")
+}
+func (UnimplementedCampaignServiceServer) DeleteCampaignUpdate(context.Context, *DeleteCampaignUpdateRequest) (*DeleteCampaignUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCampaignUpdate not implemented")
+}
 func (UnimplementedCampaignServiceServer) mustEmbedUnimplementedCampaignServiceServer() {}
 func (UnimplementedCampaignServiceServer) testEmbeddedByValue()                         {}
 
@@ -240,6 +547,348 @@ func _CampaignService_GetCampaignsByUserID_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CampaignService_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ListCampaigns(ctx, req.(*ListCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_SearchCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).SearchCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_SearchCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).SearchCampaigns(ctx, req.(*SearchCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_RecordContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).RecordContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_RecordContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).RecordContribution(ctx, req.(*RecordContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ReverseContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ReverseContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ReverseContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ReverseContribution(ctx, req.(*ReverseContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ReconcileCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ReconcileCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ReconcileCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ReconcileCampaign(ctx, req.(*ReconcileCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_PauseCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).PauseCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_PauseCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).PauseCampaign(ctx, req.(*PauseCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ResumeCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ResumeCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ResumeCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ResumeCampaign(ctx, req.(*ResumeCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_CancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).CancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_CancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).CancelCampaign(ctx, req.(*CancelCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_PublishCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).PublishCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_PublishCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).PublishCampaign(ctx, req.(*PublishCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_SubmitForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).SubmitForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_SubmitForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).SubmitForReview(ctx, req.(*SubmitForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ApproveCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ApproveCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ApproveCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ApproveCampaign(ctx, req.(*ApproveCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_RejectCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).RejectCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_RejectCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).RejectCampaign(ctx, req.(*RejectCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ListPendingReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ListPendingReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ListPendingReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ForceCancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCancelCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ForceCancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ForceCancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ForceCancelCampaign(ctx, req.(*ForceCancelCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ListAllCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ListAllCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ListAllCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ListAllCampaigns(ctx, req.(*ListAllCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_CreateCampaignUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).CreateCampaignUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_CreateCampaignUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).CreateCampaignUpdate(ctx, req.(*CreateCampaignUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ListCampaignUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ListCampaignUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ListCampaignUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ListCampaignUpdates(ctx, req.(*ListCampaignUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_EditCampaignUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCampaignUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).EditCampaignUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_EditCampaignUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).EditCampaignUpdate(ctx, req.(*EditCampaignUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_DeleteCampaignUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCampaignUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).DeleteCampaignUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_DeleteCampaignUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).DeleteCampaignUpdate(ctx, req.(*DeleteCampaignUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CampaignService_ServiceDesc is the grpc.ServiceDesc for CampaignService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +916,82 @@ var CampaignService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCampaignsByUserID",
 			Handler:    _CampaignService_GetCampaignsByUserID_Handler,
 		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _CampaignService_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "SearchCampaigns",
+			Handler:    _CampaignService_SearchCampaigns_Handler,
+		},
+		{
+			MethodName: "RecordContribution",
+			Handler:    _CampaignService_RecordContribution_Handler,
+		},
+		{
+			MethodName: "ReverseContribution",
+			Handler:    _CampaignService_ReverseContribution_Handler,
+		},
+		{
+			MethodName: "ReconcileCampaign",
+			Handler:    _CampaignService_ReconcileCampaign_Handler,
+		},
+		{
+			MethodName: "PauseCampaign",
+			Handler:    _CampaignService_PauseCampaign_Handler,
+		},
+		{
+			MethodName: "ResumeCampaign",
+			Handler:    _CampaignService_ResumeCampaign_Handler,
+		},
+		{
+			MethodName: "CancelCampaign",
+			Handler:    _CampaignService_CancelCampaign_Handler,
+		},
+		{
+			MethodName: "PublishCampaign",
+			Handler:    _CampaignService_PublishCampaign_Handler,
+		},
+		{
+			MethodName: "SubmitForReview",
+			Handler:    _CampaignService_SubmitForReview_Handler,
+		},
+		{
+			MethodName: "ApproveCampaign",
+			Handler:    _CampaignService_ApproveCampaign_Handler,
+		},
+		{
+			MethodName: "RejectCampaign",
+			Handler:    _CampaignService_RejectCampaign_Handler,
+		},
+		{
+			MethodName: "ListPendingReviews",
+			Handler:    _CampaignService_ListPendingReviews_Handler,
+		},
+		{
+			MethodName: "ForceCancelCampaign",
+			Handler:    _CampaignService_ForceCancelCampaign_Handler,
+		},
+		{
+			MethodName: "ListAllCampaigns",
+			Handler:    _CampaignService_ListAllCampaigns_Handler,
+		},
+		{
+			MethodName: "CreateCampaignUpdate",
+			Handler:    _CampaignService_CreateCampaignUpdate_Handler,
+		},
+		{
+			MethodName: "ListCampaignUpdates",
+			Handler:    _CampaignService_ListCampaignUpdates_Handler,
+		},
+		{
+			MethodName: "EditCampaignUpdate",
+			Handler:    _CampaignService_EditCampaignUpdate_Handler,
+		},
+		{
+			MethodName: "DeleteCampaignUpdate",
+			Handler:    _CampaignService_DeleteCampaignUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "campaign/v1/campaign.proto",
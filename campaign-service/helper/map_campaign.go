@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// MapCampaignProto converts a campaign row from the database into its proto message
+func MapCampaignProto(input models.CampaignDB) *campaign.Campaign {
+	result := &campaign.Campaign{
+		Id:                   input.ID,
+		UserId:               input.UserID,
+		Title:                input.Title,
+		Description:          input.Description,
+		TargetAmount:         MapMoneyProto(input.TargetAmount, input.Currency),
+		CollectedAmount:      MapMoneyProto(input.CollectedAmount, input.Currency),
+		Deadline:             timestamppb.New(input.Deadline),
+		Status:               campaign.CampaignStatus(MapStatusProto(input.Status)),
+		Category:             campaign.CampaignCategory(MapCateogryProto(input.Category)),
+		MinDonation:          MapMoneyProto(input.MinDonation, input.Currency),
+		CreatedAt:            timestamppb.New(input.CreatedAt),
+		UpdatedAt:            timestamppb.New(input.UpdatedAt),
+		AutoCompleteOnTarget: input.AutoCompleteOnTarget,
+		RejectionReason:      input.RejectionReason,
+		Etag:                 MapEtagProto(input.Version),
+	}
+	if input.DeletedAt.Valid {
+		result.DeletedAt = timestamppb.New(input.DeletedAt.Time)
+	}
+	return result
+}
+
+// MapCampaignListProto converts a slice of campaign rows into proto messages
+func MapCampaignListProto(input []models.CampaignDB) []*campaign.Campaign {
+	var result []*campaign.Campaign
+	for _, val := range input {
+		result = append(result, MapCampaignProto(val))
+	}
+	return result
+}
+
+// MapCampaignUpdateProto converts a campaign update row from the database into its proto message
+func MapCampaignUpdateProto(input models.CampaignUpdateDB) *campaign.CampaignUpdate {
+	return &campaign.CampaignUpdate{
+		Id:         input.ID,
+		CampaignId: input.CampaignID,
+		UserId:     input.UserID,
+		Title:      input.Title,
+		Content:    input.Content,
+		CreatedAt:  timestamppb.New(input.CreatedAt),
+		UpdatedAt:  timestamppb.New(input.UpdatedAt),
+	}
+}
+
+// MapCampaignUpdateListProto converts a slice of campaign update rows into proto messages
+func MapCampaignUpdateListProto(input []models.CampaignUpdateDB) []*campaign.CampaignUpdate {
+	var result []*campaign.CampaignUpdate
+	for _, val := range input {
+		result = append(result, MapCampaignUpdateProto(val))
+	}
+	return result
+}
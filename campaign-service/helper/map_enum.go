@@ -54,6 +54,9 @@ func MapStatusDB(input int32) string{
 		2: "paused",
 		3: "completed",
 		4: "cancelled",
+		5: "draft",
+		6: "pending_review",
+		7: "rejected",
 	}
 	for index, val := range status{
 		if input == index{
@@ -71,6 +74,9 @@ func MapStatusProto(input string) int32{
 		2: "paused",
 		3: "completed",
 		4: "cancelled",
+		5: "draft",
+		6: "pending_review",
+		7: "rejected",
 	}
 	for index, val := range status{
 		if input == val{
@@ -78,4 +84,22 @@ func MapStatusProto(input string) int32{
 		}
 	}
 	return result 
-}
\ No newline at end of file
+}
+
+func MapSortFieldDB(input int32) string{
+	var result string
+	// define sort keys understood by the repository
+	sortFields := map[int32]string{
+		0: "created_at",
+		1: "created_at",
+		2: "deadline",
+		3: "collected_amount",
+		4: "percent_funded",
+	}
+	for index, val := range sortFields{
+		if input == index{
+			result = val
+		}
+	}
+	return result 
+}
@@ -0,0 +1,25 @@
+package helper
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MapEtagProto formats a campaign version as the etag returned to clients
+func MapEtagProto(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
+
+// MapEtagVersion parses an etag sent by a client back into the version it was made from
+func MapEtagVersion(etag string) (int64, error) {
+	if etag == "" {
+		return 0, status.Error(codes.InvalidArgument, "etag is required, read the campaign first")
+	}
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid etag %q", etag)
+	}
+	return version, nil
+}
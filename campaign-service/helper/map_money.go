@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+)
+
+// DefaultCurrency is used when a Money message has no currency code
+const DefaultCurrency = "IDR"
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// MapMoneyProto builds a Money message from minor units stored in the database
+func MapMoneyProto(units int64, currency string) *campaign.Money {
+	return &campaign.Money{
+		CurrencyCode: currency,
+		Units:        units,
+	}
+}
+
+// MapMoneyCurrency returns the ISO 4217 code of a Money message, applying the default
+func MapMoneyCurrency(input *campaign.Money) string {
+	if input == nil || input.CurrencyCode == "" {
+		return DefaultCurrency
+	}
+	return strings.ToUpper(input.CurrencyCode)
+}
+
+// MapMoneyUnits returns the minor units of a Money message, zero when it is not set
+func MapMoneyUnits(input *campaign.Money) int64 {
+	if input == nil {
+		return 0
+	}
+	return input.Units
+}
+
+// CommonCurrency checks that every non nil Money shares one valid currency and returns it.
+// It returns an empty string when no amount is set.
+func CommonCurrency(amounts ...*campaign.Money) (string, error) {
+	var result string
+	for _, val := range amounts {
+		if val == nil {
+			continue
+		}
+		currency := MapMoneyCurrency(val)
+		if !currencyCodePattern.MatchString(currency) {
+			return "", status.Errorf(codes.InvalidArgument, "invalid currency code %q", val.CurrencyCode)
+		}
+		if val.Units < 0 {
+			return "", status.Error(codes.InvalidArgument, "amount cannot be negative")
+		}
+		if result != "" && result != currency {
+			return "", status.Errorf(codes.InvalidArgument, "mixed currencies %v and %v are not allowed", result, currency)
+		}
+		result = currency
+	}
+	return result, nil
+}
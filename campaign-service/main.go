@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -9,10 +10,12 @@ import (
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
 
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/auth"
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/config"
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/service"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/validation"
 )
 
 func main() {
@@ -24,18 +27,36 @@ func main() {
 		}
 	}
 
-	// Initialize database connection
-	config.InitDB()
-	gorm := config.DB
+	// Create a new grpc server that authenticates bearer tokens, checks the caller's roles
+	// and then validates the request. Errors of all of them are mapped to gRPC statuses.
+	// Tokens are verified on every storage backend, see config.InitTokenVerifier for the JWT variables.
+	verifier := config.InitTokenVerifier()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			service.UnaryErrorInterceptor(),
+			auth.UnaryServerInterceptor(verifier),
+			auth.UnaryAuthorizationInterceptor(service.CampaignPolicy),
+			validation.UnaryServerInterceptor(service.CampaignRules),
+		),
+		grpc.ChainStreamInterceptor(
+			service.StreamErrorInterceptor(),
+			auth.StreamServerInterceptor(verifier),
+			auth.StreamAuthorizationInterceptor(service.CampaignPolicy),
+			validation.StreamServerInterceptor(service.CampaignRules),
+		),
+	)
 
-	// Create a new grpc server
-	grpcServer := grpc.NewServer()
-
-	// Create repository instances
-	campaignRepo := repository.NewCampaignRepository(gorm)
+	// Create repository instances on the configured storage
+	campaignRepo, campaignUpdateRepo, idempotencyRepo := initRepositories()
 
 	// Inject repositories into services
-	campaignService := service.NewCampaignService(campaignRepo)
+	campaignService := service.NewCampaignService(campaignRepo, campaignUpdateRepo, idempotencyRepo)
+
+	// Complete campaigns in the background
+	if interval := config.CompletionInterval(); interval > 0 {
+		completionJob := service.NewCompletionJob(campaignRepo, interval)
+		go completionJob.Run(context.Background())
+	}
 
 	// Register server with grpc
 	campaign.RegisterCampaignServiceServer(grpcServer, campaignService)
@@ -44,7 +65,7 @@ func main() {
 	if port == "" {
 		port = "5051"
 	}
-	// Listener for grpcServer without interceptors
+	// Listener for grpcServer
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		log.Fatalf("failed to listen on PORT: %v", err)
@@ -55,3 +76,31 @@ func main() {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
+
+// initRepositories creates the repositories for the storage backend selected by STORAGE_BACKEND
+func initRepositories() (repository.CampaignRepository, repository.CampaignUpdateRepository, repository.IdempotencyRepository) {
+	switch config.StorageBackend() {
+	case config.StorageMemory:
+		log.Println("Using in-memory storage, data is lost on restart")
+		return repository.NewMemoryCampaignRepository(), repository.NewMemoryCampaignUpdateRepository(), repository.NewMemoryIdempotencyRepository()
+	case config.StorageMongo:
+		mongoDB := config.InitMongo()
+		if err := repository.EnsureMongoIndexes(context.Background(), mongoDB); err != nil {
+			log.Fatalf("Failed to create MongoDB indexes: %v", err)
+		}
+		return repository.NewMongoCampaignRepository(mongoDB), repository.NewMongoCampaignUpdateRepository(mongoDB), repository.NewMongoIdempotencyRepository(mongoDB)
+	}
+
+	// Initialize database connection
+	config.InitDB()
+	gorm := config.DB
+
+	// Bound every query so a slow database cannot pile up requests
+	if timeout := config.QueryTimeout(); timeout > 0 {
+		if err := gorm.Use(repository.NewQueryTimeout(timeout)); err != nil {
+			log.Fatalf("Failed to register query timeout: %v", err)
+		}
+	}
+
+	return repository.NewCampaignRepository(gorm), repository.NewCampaignUpdateRepository(gorm), repository.NewIdempotencyRepository(gorm)
+}
@@ -0,0 +1,127 @@
+-- First, create ENUM types for status and category
+CREATE TYPE campaign_status AS ENUM (
+    'active',
+    'paused',
+    'completed',
+    'cancelled'
+);
+
+CREATE TYPE campaign_category AS ENUM (
+    'education',
+    'healthcare',
+    'environment',
+    'animals',
+    'emergency',
+    'community',
+    'technology',
+    'arts',
+    'sports'
+);
+
+ALTER TYPE campaign_category ADD VALUE 'unspecified' BEFORE 'education';
+
+-- Now, create the campaigns table
+CREATE TABLE campaigns (
+    id UUID PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    description VARCHAR(255),
+    target_amount INTEGER NOT NULL,
+    collected_amount INTEGER DEFAULT 0,
+    deadline DATE NOT NULL,
+    status campaign_status NOT NULL DEFAULT 'active',
+    category campaign_category NOT NULL,
+    min_donation INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+
+-- Full-text search over title and description, title weighted above description
+ALTER TABLE campaigns.campaigns ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX campaigns_search_vector_idx ON campaigns.campaigns USING GIN (search_vector);
+
+
+-- Money amounts are stored as BIGINT minor units (e.g. sen) with an ISO 4217 currency code.
+-- Existing rows hold whole rupiah, so they are converted to sen.
+ALTER TABLE campaigns.campaigns ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE campaigns.campaigns
+    ALTER COLUMN target_amount TYPE BIGINT USING target_amount::BIGINT * 100,
+    ALTER COLUMN collected_amount TYPE BIGINT USING collected_amount::BIGINT * 100,
+    ALTER COLUMN min_donation TYPE BIGINT USING min_donation::BIGINT * 100;
+ALTER TABLE campaigns.campaigns
+    ADD CONSTRAINT campaigns_amounts_non_negative
+    CHECK (target_amount >= 0 AND collected_amount >= 0 AND min_donation >= 0);
+
+
+-- Idempotency keys with the stored response of the first successful request
+CREATE TABLE campaigns.idempotency_keys (
+    key VARCHAR(255) NOT NULL,
+    operation VARCHAR(100) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    response BYTEA,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (key, operation)
+);
+
+
+-- Ledger of refunds and chargebacks taken back from campaigns
+CREATE TABLE campaigns.campaign_reversals (
+    id VARCHAR(255) PRIMARY KEY,
+    campaign_id UUID NOT NULL REFERENCES campaigns.campaigns (id),
+    contribution_id VARCHAR(255) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX campaign_reversals_campaign_id_idx ON campaigns.campaign_reversals (campaign_id);
+
+
+-- Ledger of contributions credited to campaigns. collected_amount equals the sum of
+-- contributions minus the sum of reversals.
+CREATE TABLE campaigns.campaign_contributions (
+    id VARCHAR(255) PRIMARY KEY,
+    campaign_id UUID NOT NULL REFERENCES campaigns.campaigns (id),
+    currency CHAR(3) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    source VARCHAR(50) NOT NULL DEFAULT 'donation',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX campaign_contributions_campaign_id_idx ON campaigns.campaign_contributions (campaign_id);
+CREATE INDEX campaign_reversals_contribution_id_idx ON campaigns.campaign_reversals (contribution_id);
+
+-- Amounts collected before the ledger existed become one opening balance entry per campaign
+INSERT INTO campaigns.campaign_contributions (id, campaign_id, currency, amount, source)
+SELECT 'opening-' || id, id, currency, collected_amount, 'opening_balance'
+FROM campaigns.campaigns
+WHERE collected_amount > 0;
+
+
+-- Campaigns can opt in to complete as soon as their target is reached
+ALTER TABLE campaigns.campaigns ADD COLUMN auto_complete_on_target BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX campaigns_status_deadline_idx ON campaigns.campaigns (status, deadline);
+
+
+-- History of status changes with the reason given by the user
+CREATE TABLE campaigns.campaign_status_changes (
+    id BIGSERIAL PRIMARY KEY,
+    campaign_id UUID NOT NULL REFERENCES campaigns.campaigns (id),
+    user_id INTEGER NOT NULL,
+    from_status campaign_status NOT NULL,
+    to_status campaign_status NOT NULL,
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX campaign_status_changes_campaign_id_idx ON campaigns.campaign_status_changes (campaign_id);
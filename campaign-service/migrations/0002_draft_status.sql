@@ -0,0 +1,3 @@
+-- New campaigns start as drafts. An enum value cannot be used in the transaction that adds it,
+-- so it is added in a step of its own and made the default in the next one.
+ALTER TYPE campaign_status ADD VALUE 'draft';
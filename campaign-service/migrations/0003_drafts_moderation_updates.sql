@@ -0,0 +1,27 @@
+-- New campaigns start as drafts and go live with PublishCampaign
+ALTER TABLE campaigns.campaigns ALTER COLUMN status SET DEFAULT 'draft';
+
+
+-- Moderation: campaigns are reviewed by staff before they go live
+ALTER TYPE campaign_status ADD VALUE 'pending_review';
+ALTER TYPE campaign_status ADD VALUE 'rejected';
+ALTER TABLE campaigns.campaigns ADD COLUMN rejection_reason VARCHAR(255) NOT NULL DEFAULT '';
+
+
+-- News posts the campaign owner publishes on a campaign
+CREATE TABLE campaigns.campaign_updates (
+    id UUID PRIMARY KEY,
+    campaign_id UUID NOT NULL REFERENCES campaigns.campaigns (id),
+    user_id INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    content VARCHAR(2000) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX campaign_updates_campaign_id_idx ON campaigns.campaign_updates (campaign_id, created_at DESC);
+
+
+-- Optimistic concurrency: every edit or status change bumps the version, exposed as the etag
+ALTER TABLE campaigns.campaigns ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
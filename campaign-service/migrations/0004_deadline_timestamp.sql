@@ -0,0 +1,4 @@
+-- Deadlines are a point in time, not a day, so campaigns complete when they are due rather than
+-- at midnight. Existing dates become midnight UTC, the moment they used to be compared at.
+ALTER TABLE campaigns.campaigns
+    ALTER COLUMN deadline TYPE TIMESTAMPTZ USING deadline::TIMESTAMP AT TIME ZONE 'UTC';
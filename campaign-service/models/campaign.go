@@ -11,12 +11,19 @@ type CampaignDB struct {
     UserID          int32
     Title           string
     Description     string
-    TargetAmount    int32
-    CollectedAmount int32
+    Currency        string `gorm:"default:'IDR'"`
+    TargetAmount    int64
+    CollectedAmount int64
     Deadline        time.Time
-    Status          string `gorm:"type:campaign_status;default:'active'"`
+    Status          string `gorm:"type:campaign_status;default:'draft'"`
     Category        string
-    MinDonation     int32
+    MinDonation     int64
+    // Complete the campaign as soon as CollectedAmount reaches TargetAmount
+    AutoCompleteOnTarget bool
+    // Why a moderator rejected the campaign, empty otherwise
+    RejectionReason string
+    // Bumped on every change made by a user or a status change, exposed as the etag
+    Version         int64 `gorm:"default:1"`
     CreatedAt       time.Time
     UpdatedAt       time.Time
     DeletedAt       gorm.DeletedAt
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+type CampaignContributionDB struct {
+    ID         string `gorm:"primaryKey"`
+    CampaignID string
+    Currency   string
+    Amount     int64
+    Source     string
+    CreatedAt  time.Time
+}
+
+// Target schema and table
+func (CampaignContributionDB) TableName() string {
+    return "campaigns.campaign_contributions"
+}
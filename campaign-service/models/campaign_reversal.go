@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+type CampaignReversalDB struct {
+    ID             string `gorm:"primaryKey"`
+    CampaignID     string
+    ContributionID string
+    Currency       string
+    Amount         int64
+    Reason         string
+    CreatedAt      time.Time
+}
+
+// Target schema and table
+func (CampaignReversalDB) TableName() string {
+    return "campaigns.campaign_reversals"
+}
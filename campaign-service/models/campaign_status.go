@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+)
+
+// Campaign status values stored in the campaign_status enum
+const (
+	StatusActive        = "active"
+	StatusPaused        = "paused"
+	StatusCompleted     = "completed"
+	StatusCancelled     = "cancelled"
+	StatusDraft         = "draft"
+	StatusPendingReview = "pending_review"
+	StatusRejected      = "rejected"
+)
+
+// PublicStatuses are the statuses visible when browsing or searching campaigns
+var PublicStatuses = []string{StatusActive, StatusPaused, StatusCompleted, StatusCancelled}
+
+// AllStatuses are every status a campaign can have
+var AllStatuses = []string{StatusDraft, StatusPendingReview, StatusRejected, StatusActive, StatusPaused, StatusCompleted, StatusCancelled}
+
+// StatusTransitions maps each status to the statuses a campaign may move to from it
+type StatusTransitions map[string][]string
+
+// Allows reports whether a campaign may move from one status to another
+func (t StatusTransitions) Allows(from string, to string) bool {
+	for _, val := range t[from] {
+		if val == to {
+			return true
+		}
+	}
+	return false
+}
+
+// SystemUserID is recorded in the status history for changes the service makes on its own
+const SystemUserID int32 = 0
+
+// CampaignTransitions lists the statuses a user may move a campaign to from each status.
+// Leaving pending_review for active or rejected is reserved to moderators, see ModeratorTransitions.
+var CampaignTransitions = StatusTransitions{
+	StatusDraft:         {StatusPendingReview, StatusCancelled},
+	StatusPendingReview: {StatusCancelled},
+	StatusRejected:      {StatusPendingReview, StatusCancelled},
+	StatusActive:        {StatusPaused, StatusCancelled},
+	StatusPaused:        {StatusActive, StatusCancelled},
+	StatusCompleted:     {},
+	StatusCancelled:     {},
+}
+
+// ModeratorTransitions lists the decisions a moderator may take on a campaign waiting for review
+var ModeratorTransitions = StatusTransitions{
+	StatusPendingReview: {StatusActive, StatusRejected},
+}
+
+// SystemTransitions lists the status changes only the service itself makes:
+// auto completion, and reversals that drop a completed campaign below its target
+var SystemTransitions = StatusTransitions{
+	StatusActive:    {StatusCompleted},
+	StatusCompleted: {StatusActive},
+}
+
+// IsEditable reports whether a campaign in the given status is still being prepared,
+// so every field including its currency may change
+func IsEditable(status string) bool {
+	return status == StatusDraft || status == StatusRejected
+}
+
+type CampaignStatusChangeDB struct {
+    ID         uint `gorm:"primaryKey"`
+    CampaignID string
+    UserID     int32
+    FromStatus string `gorm:"type:campaign_status"`
+    ToStatus   string `gorm:"type:campaign_status"`
+    Reason     string
+    CreatedAt  time.Time
+}
+
+// Target schema and table
+func (CampaignStatusChangeDB) TableName() string {
+    return "campaigns.campaign_status_changes"
+}
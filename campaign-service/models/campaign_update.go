@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CampaignUpdateDB struct {
+    ID         string `gorm:"primaryKey"`
+    CampaignID string
+    UserID     int32
+    Title      string
+    Content    string
+    CreatedAt  time.Time
+    UpdatedAt  time.Time
+    DeletedAt  gorm.DeletedAt
+}
+
+// Target schema and table
+func (CampaignUpdateDB) TableName() string {
+    return "campaigns.campaign_updates"
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+type IdempotencyKeyDB struct {
+    Key         string `gorm:"primaryKey"`
+    Operation   string `gorm:"primaryKey"`
+    RequestHash string
+    Response    []byte
+    CreatedAt   time.Time
+    UpdatedAt   time.Time
+}
+
+// Target schema and table
+func (IdempotencyKeyDB) TableName() string {
+    return "campaigns.idempotency_keys"
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// CampaignReconciliation compares the stored collected amount of a campaign with the
+// amount derived from its ledger. Drift is stored minus ledger.
+type CampaignReconciliation struct {
+	Campaign     models.CampaignDB
+	LedgerAmount int64
+	Drift        int64
+	Fixed        bool
+}
+
+func (r *campaignRepository) RecordContribution(ctx context.Context, contribution models.CampaignContributionDB) (models.CampaignDB, error) {
+	var campaign models.CampaignDB
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Add the ledger entry, a contribution can only be credited once
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&contribution)
+		if result.Error != nil {
+			return dbError(result.Error, "Error recording contribution")
+		}
+		if result.RowsAffected == 0 {
+			return newError(ErrAlreadyExists, "CONTRIBUTION_ALREADY_RECORDED", "contribution %v was already recorded", contribution.ID)
+		}
+
+		// Lock the campaign so the rules below are checked against the row that is incremented,
+		// concurrent donations to it wait for this one
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&campaign, "id=?", contribution.CampaignID).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		if err := contributionError(campaign, contribution); err != nil {
+			return err
+		}
+
+		// Only a status change bumps the version, donations alone keep etags valid
+		if err := tx.Model(&campaign).Clauses(clause.Returning{}).Update("collected_amount", gorm.Expr("collected_amount + ?", contribution.Amount)).Error; err != nil {
+			return dbError(err, "Error recording contribution")
+		}
+
+		// Complete the campaign right away when it opted in and reached its target
+		if campaign.AutoCompleteOnTarget && campaign.CollectedAmount >= campaign.TargetAmount {
+			return transitionStatus(tx, &campaign, models.SystemTransitions, models.SystemUserID, models.StatusCompleted, "target reached")
+		}
+		return nil
+	})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	return campaign, nil
+}
+
+// contributionError returns the rule that keeps contribution from being credited to campaign, or nil
+func contributionError(campaign models.CampaignDB, contribution models.CampaignContributionDB) error {
+	switch {
+	case campaign.Status != "active":
+		return newError(ErrPrecondition, "CAMPAIGN_NOT_ACTIVE", "campaign status is %v", campaign.Status)
+	case !campaign.Deadline.After(time.Now()):
+		return newError(ErrPrecondition, "CAMPAIGN_DEADLINE_PASSED", "campaign deadline has passed")
+	case campaign.Currency != contribution.Currency:
+		return newError(ErrInvalidArgument, "CURRENCY_MISMATCH", "campaign currency is %v, got %v", campaign.Currency, contribution.Currency)
+	case contribution.Amount < campaign.MinDonation:
+		return newError(ErrInvalidArgument, "BELOW_MIN_DONATION", "amount is below the minimum donation of %v", campaign.MinDonation)
+	}
+	return nil
+}
+
+func (r *campaignRepository) ReverseContribution(ctx context.Context, reversal models.CampaignReversalDB) (models.CampaignDB, error) {
+	var campaign models.CampaignDB
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the original contribution so parallel refunds of it are serialized
+		var contribution models.CampaignContributionDB
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&contribution, "id=? AND campaign_id=?", reversal.ContributionID, reversal.CampaignID).Error; err != nil {
+			return notFoundError(err, "CONTRIBUTION_NOT_FOUND", fmt.Sprintf("contribution %v not found for campaign", reversal.ContributionID))
+		}
+		if contribution.Currency != reversal.Currency {
+			return newError(ErrInvalidArgument, "CURRENCY_MISMATCH", "contribution currency is %v, got %v", contribution.Currency, reversal.Currency)
+		}
+
+		// A contribution cannot be reversed for more than it was worth
+		var reversed int64
+		if err := tx.Model(&models.CampaignReversalDB{}).Where("contribution_id=?", contribution.ID).Select("COALESCE(SUM(amount), 0)").Scan(&reversed).Error; err != nil {
+			return dbError(err, "Error reading reversals")
+		}
+		if reversed+reversal.Amount > contribution.Amount {
+			return newError(ErrPrecondition, "REVERSAL_EXCEEDS_CONTRIBUTION", "reversal exceeds the remaining contribution amount of %v", contribution.Amount-reversed)
+		}
+
+		// Lock the campaign, cancelled (soft deleted) campaigns can still be refunded
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).First(&campaign, "id=?", reversal.CampaignID).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		if err := reversalError(campaign, reversal); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&campaign).Clauses(clause.Returning{}).Update("collected_amount", gorm.Expr("collected_amount - ?", reversal.Amount)).Error; err != nil {
+			return dbError(err, "Error reversing contribution")
+		}
+
+		// Re-open a completed campaign that falls under its target
+		if campaign.Status == models.StatusCompleted && campaign.CollectedAmount < campaign.TargetAmount && campaign.Deadline.After(time.Now()) {
+			if err := transitionStatus(tx, &campaign, models.SystemTransitions, models.SystemUserID, models.StatusActive, "reversal dropped below target"); err != nil {
+				return err
+			}
+		}
+
+		// Keep the reversal in the ledger
+		if err := tx.Create(&reversal).Error; err != nil {
+			return dbError(err, "Error recording reversal")
+		}
+		return nil
+	})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	return campaign, nil
+}
+
+// reversalError returns the rule that keeps reversal from being taken back from campaign, or nil
+func reversalError(campaign models.CampaignDB, reversal models.CampaignReversalDB) error {
+	if campaign.Currency != reversal.Currency {
+		return newError(ErrInvalidArgument, "CURRENCY_MISMATCH", "campaign currency is %v, got %v", campaign.Currency, reversal.Currency)
+	}
+	if campaign.CollectedAmount < reversal.Amount {
+		return newError(ErrPrecondition, "REVERSAL_EXCEEDS_COLLECTED", "reversal of %v exceeds the collected amount of %v", reversal.Amount, campaign.CollectedAmount)
+	}
+	return nil
+}
+
+func (r *campaignRepository) ReconcileCampaign(ctx context.Context, id string, fix bool) (CampaignReconciliation, error) {
+	var reconciliation CampaignReconciliation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the campaign so no contribution lands between summing and fixing
+		campaign := &reconciliation.Campaign
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).First(campaign, "id=?", id).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+
+		// collected_amount = contributions - reversals
+		var contributed, reversed int64
+		if err := tx.Model(&models.CampaignContributionDB{}).Where("campaign_id=?", id).Select("COALESCE(SUM(amount), 0)").Scan(&contributed).Error; err != nil {
+			return dbError(err, "Error summing contributions")
+		}
+		if err := tx.Model(&models.CampaignReversalDB{}).Where("campaign_id=?", id).Select("COALESCE(SUM(amount), 0)").Scan(&reversed).Error; err != nil {
+			return dbError(err, "Error summing reversals")
+		}
+		reconciliation.LedgerAmount = contributed - reversed
+		reconciliation.Drift = campaign.CollectedAmount - reconciliation.LedgerAmount
+
+		if !fix || reconciliation.Drift == 0 {
+			return nil
+		}
+
+		// Reset the stored amount to what the ledger says
+		if err := tx.Unscoped().Model(campaign).Clauses(clause.Returning{}).Where("id=?", id).Update("collected_amount", reconciliation.LedgerAmount).Error; err != nil {
+			return dbError(err, "Error fixing collected amount")
+		}
+		reconciliation.Fixed = true
+		return nil
+	})
+	if err != nil {
+		return CampaignReconciliation{}, err
+	}
+	return reconciliation, nil
+}
@@ -0,0 +1,601 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// campaignColumnSetters copy one column of a campaign, used to apply the columns of UpdateCampaignByID
+var campaignColumnSetters = map[string]func(dst *models.CampaignDB, src models.CampaignDB){
+	"title":         func(dst *models.CampaignDB, src models.CampaignDB) { dst.Title = src.Title },
+	"description":   func(dst *models.CampaignDB, src models.CampaignDB) { dst.Description = src.Description },
+	"currency":      func(dst *models.CampaignDB, src models.CampaignDB) { dst.Currency = src.Currency },
+	"target_amount": func(dst *models.CampaignDB, src models.CampaignDB) { dst.TargetAmount = src.TargetAmount },
+	"deadline":      func(dst *models.CampaignDB, src models.CampaignDB) { dst.Deadline = src.Deadline },
+	"category":      func(dst *models.CampaignDB, src models.CampaignDB) { dst.Category = src.Category },
+	"min_donation":  func(dst *models.CampaignDB, src models.CampaignDB) { dst.MinDonation = src.MinDonation },
+	"auto_complete_on_target": func(dst *models.CampaignDB, src models.CampaignDB) {
+		dst.AutoCompleteOnTarget = src.AutoCompleteOnTarget
+	},
+}
+
+// memoryCampaignRepository keeps campaigns and their ledger in memory. It follows the same rules
+// as the gorm implementation and is meant for local development and tests, data is lost on restart.
+type memoryCampaignRepository struct {
+	mu            sync.RWMutex
+	campaigns     map[string]models.CampaignDB
+	contributions map[string]models.CampaignContributionDB
+	reversals     map[string]models.CampaignReversalDB
+	statusChanges []models.CampaignStatusChangeDB
+}
+
+// Constructor NewMemoryCampaignRepository creates and returns an empty in-memory CampaignRepository.
+func NewMemoryCampaignRepository() CampaignRepository {
+	return &memoryCampaignRepository{
+		campaigns:     map[string]models.CampaignDB{},
+		contributions: map[string]models.CampaignContributionDB{},
+		reversals:     map[string]models.CampaignReversalDB{},
+	}
+}
+
+func (r *memoryCampaignRepository) CreateCampaign(ctx context.Context, campaign models.CampaignDB) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Failed to create a campaign")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.campaigns[campaign.ID]; ok {
+		return models.CampaignDB{}, newError(ErrAlreadyExists, "ALREADY_EXISTS", "Failed to create a campaign")
+	}
+
+	applyCampaignDefaults(&campaign, time.Now())
+	r.campaigns[campaign.ID] = campaign
+	return campaign, nil
+}
+
+func (r *memoryCampaignRepository) GetCampaignByID(ctx context.Context, id string) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Error reading campaign")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.campaign(id, false)
+}
+
+func (r *memoryCampaignRepository) DeleteCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, version int64) error {
+	if err := ctx.Err(); err != nil {
+		return dbError(err, "Error deleting campaign")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	campaign, err := r.campaign(id, false)
+	if err != nil {
+		return err
+	}
+	if err := checkOwner(campaign, ownerID); err != nil {
+		return err
+	}
+	if err := checkVersion(campaign, version); err != nil {
+		return err
+	}
+
+	// Update status to "cancelled" through the status rules, then delete data
+	if err := r.transitionStatus(&campaign, models.CampaignTransitions, actorID, models.StatusCancelled, "deleted"); err != nil {
+		return err
+	}
+	campaign.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	r.campaigns[id] = campaign
+	return nil
+}
+
+// UpdateCampaignByID writes exactly the given columns of campaign, including zero values.
+// campaign.Version is the version the caller expects the stored campaign to have.
+func (r *memoryCampaignRepository) UpdateCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, campaign models.CampaignDB, fields []string) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Error updating campaign")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	retreivedCampaign, err := r.campaign(id, false)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkOwner(retreivedCampaign, ownerID); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkVersion(retreivedCampaign, campaign.Version); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	if err := currencyChangeError(retreivedCampaign, campaign, fields); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// check if current status cancelled, completed or under review
+	if retreivedCampaign.Status == models.StatusCancelled || retreivedCampaign.Status == models.StatusCompleted || retreivedCampaign.Status == models.StatusPendingReview {
+		return models.CampaignDB{}, newError(ErrPrecondition, "CAMPAIGN_NOT_EDITABLE", "campaign status is %v", retreivedCampaign.Status)
+	}
+	for _, field := range fields {
+		if _, ok := campaignColumnSetters[field]; !ok && field != "status" {
+			return models.CampaignDB{}, newError(ErrInvalidArgument, "UNKNOWN_FIELD", "Unknown campaign field %v", field)
+		}
+	}
+
+	if err := minDonationError(retreivedCampaign, campaign, fields); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// Status changes follow the same rules as the dedicated status RPCs and are checked
+	// against the stored campaign before any other column changes
+	if slices.Contains(fields, "status") && campaign.Status != retreivedCampaign.Status {
+		if err := r.transitionStatus(&retreivedCampaign, models.CampaignTransitions, actorID, campaign.Status, "updated"); err != nil {
+			return models.CampaignDB{}, err
+		}
+	}
+
+	changed := false
+	for _, field := range fields {
+		if field != "status" {
+			campaignColumnSetters[field](&retreivedCampaign, campaign)
+			changed = true
+		}
+	}
+	if changed {
+		retreivedCampaign.Version++
+		retreivedCampaign.UpdatedAt = time.Now()
+	}
+	r.campaigns[id] = retreivedCampaign
+	return retreivedCampaign, nil
+}
+
+func (r *memoryCampaignRepository) GetCampaignsByUserID(ctx context.Context, userID int32, opts CampaignListOptions) (CampaignPage, error) {
+	if err := ctx.Err(); err != nil {
+		return CampaignPage{}, dbError(err, "Error listing campaigns")
+	}
+	cursor, err := listCursor(&opts)
+	if err != nil {
+		return CampaignPage{}, err
+	}
+
+	r.mu.RLock()
+	campaigns := r.matchingCampaigns(opts, func(campaign models.CampaignDB) bool {
+		return campaign.UserID == userID && !campaign.DeletedAt.Valid
+	})
+	r.mu.RUnlock()
+
+	// Count every matching campaign of the user regardless of the page
+	page := pageCampaigns(campaigns, opts, cursor)
+	page.TotalCount = int64(len(campaigns))
+	return page, nil
+}
+
+func (r *memoryCampaignRepository) ListCampaigns(ctx context.Context, opts CampaignListOptions) (CampaignPage, error) {
+	if err := ctx.Err(); err != nil {
+		return CampaignPage{}, dbError(err, "Error listing campaigns")
+	}
+	// Drafts are only visible to their owner
+	if len(opts.Statuses) == 0 {
+		opts.Statuses = models.PublicStatuses
+	}
+	cursor, err := listCursor(&opts)
+	if err != nil {
+		return CampaignPage{}, err
+	}
+
+	r.mu.RLock()
+	campaigns := r.matchingCampaigns(opts, func(campaign models.CampaignDB) bool {
+		return opts.IncludeDeleted || !campaign.DeletedAt.Valid
+	})
+	r.mu.RUnlock()
+
+	return pageCampaigns(campaigns, opts, cursor), nil
+}
+
+func (r *memoryCampaignRepository) SearchCampaigns(ctx context.Context, opts CampaignSearchOptions) (CampaignSearchPage, error) {
+	if err := ctx.Err(); err != nil {
+		return CampaignSearchPage{}, dbError(err, "Error searching campaigns")
+	}
+	terms, cursor, err := prepareSearch(&opts)
+	if err != nil {
+		return CampaignSearchPage{}, err
+	}
+
+	r.mu.RLock()
+	campaigns := r.matchingCampaigns(CampaignListOptions{Statuses: opts.Statuses, Categories: opts.Categories}, func(campaign models.CampaignDB) bool {
+		return !campaign.DeletedAt.Valid
+	})
+	r.mu.RUnlock()
+
+	return rankSearchResults(campaigns, terms, cursor, normalizePageSize(opts.PageSize)), nil
+}
+
+func (r *memoryCampaignRepository) RecordContribution(ctx context.Context, contribution models.CampaignContributionDB) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Error recording contribution")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// A contribution can only be credited once
+	if _, ok := r.contributions[contribution.ID]; ok {
+		return models.CampaignDB{}, newError(ErrAlreadyExists, "CONTRIBUTION_ALREADY_RECORDED", "contribution %v was already recorded", contribution.ID)
+	}
+	campaign, err := r.campaign(contribution.CampaignID, false)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := contributionError(campaign, contribution); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// Complete the campaign right away when it opted in and reached its target.
+	// Only the status change bumps the version, donations alone keep etags valid.
+	campaign.CollectedAmount += contribution.Amount
+	campaign.UpdatedAt = time.Now()
+	if campaign.AutoCompleteOnTarget && campaign.CollectedAmount >= campaign.TargetAmount {
+		if err := r.transitionStatus(&campaign, models.SystemTransitions, models.SystemUserID, models.StatusCompleted, "target reached"); err != nil {
+			return models.CampaignDB{}, err
+		}
+	}
+
+	if contribution.CreatedAt.IsZero() {
+		contribution.CreatedAt = campaign.UpdatedAt
+	}
+	r.contributions[contribution.ID] = contribution
+	r.campaigns[campaign.ID] = campaign
+	return campaign, nil
+}
+
+func (r *memoryCampaignRepository) ReverseContribution(ctx context.Context, reversal models.CampaignReversalDB) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Error reversing contribution")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	contribution, ok := r.contributions[reversal.ContributionID]
+	if !ok || contribution.CampaignID != reversal.CampaignID {
+		return models.CampaignDB{}, newError(ErrNotFound, "CONTRIBUTION_NOT_FOUND", "contribution %v not found for campaign", reversal.ContributionID)
+	}
+	if contribution.Currency != reversal.Currency {
+		return models.CampaignDB{}, newError(ErrInvalidArgument, "CURRENCY_MISMATCH", "contribution currency is %v, got %v", contribution.Currency, reversal.Currency)
+	}
+
+	// A contribution cannot be reversed for more than it was worth
+	var reversed int64
+	for _, val := range r.reversals {
+		if val.ContributionID == contribution.ID {
+			reversed += val.Amount
+		}
+	}
+	if reversed+reversal.Amount > contribution.Amount {
+		return models.CampaignDB{}, newError(ErrPrecondition, "REVERSAL_EXCEEDS_CONTRIBUTION", "reversal exceeds the remaining contribution amount of %v", contribution.Amount-reversed)
+	}
+
+	// Cancelled (soft deleted) campaigns can still be refunded
+	campaign, err := r.campaign(reversal.CampaignID, true)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := reversalError(campaign, reversal); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if _, ok := r.reversals[reversal.ID]; ok {
+		return models.CampaignDB{}, newError(ErrAlreadyExists, "ALREADY_EXISTS", "Error recording reversal")
+	}
+
+	// Re-open a completed campaign that falls under its target
+	now := time.Now()
+	campaign.CollectedAmount -= reversal.Amount
+	campaign.UpdatedAt = now
+	if campaign.Status == models.StatusCompleted && campaign.CollectedAmount < campaign.TargetAmount && campaign.Deadline.After(now) {
+		if err := r.transitionStatus(&campaign, models.SystemTransitions, models.SystemUserID, models.StatusActive, "reversal dropped below target"); err != nil {
+			return models.CampaignDB{}, err
+		}
+	}
+
+	// Keep the reversal in the ledger
+	if reversal.CreatedAt.IsZero() {
+		reversal.CreatedAt = now
+	}
+	r.reversals[reversal.ID] = reversal
+	r.campaigns[campaign.ID] = campaign
+	return campaign, nil
+}
+
+func (r *memoryCampaignRepository) ReconcileCampaign(ctx context.Context, id string, fix bool) (CampaignReconciliation, error) {
+	if err := ctx.Err(); err != nil {
+		return CampaignReconciliation{}, dbError(err, "Error reconciling campaign")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	campaign, err := r.campaign(id, true)
+	if err != nil {
+		return CampaignReconciliation{}, err
+	}
+
+	// collected_amount = contributions - reversals
+	var ledger int64
+	for _, val := range r.contributions {
+		if val.CampaignID == id {
+			ledger += val.Amount
+		}
+	}
+	for _, val := range r.reversals {
+		if val.CampaignID == id {
+			ledger -= val.Amount
+		}
+	}
+
+	reconciliation := CampaignReconciliation{
+		Campaign:     campaign,
+		LedgerAmount: ledger,
+		Drift:        campaign.CollectedAmount - ledger,
+	}
+	if !fix || reconciliation.Drift == 0 {
+		return reconciliation, nil
+	}
+
+	// Reset the stored amount to what the ledger says
+	campaign.CollectedAmount = ledger
+	campaign.UpdatedAt = time.Now()
+	r.campaigns[id] = campaign
+	reconciliation.Campaign = campaign
+	reconciliation.Fixed = true
+	return reconciliation, nil
+}
+
+// CompleteDueCampaigns marks active campaigns as completed once their deadline has passed, or once they
+// reached their target when AutoCompleteOnTarget is set. It returns how many campaigns were completed.
+func (r *memoryCampaignRepository) CompleteDueCampaigns(ctx context.Context, now time.Time) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, dbError(err, "Error completing campaigns")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var completed int64
+	for _, val := range r.campaigns {
+		if val.DeletedAt.Valid || val.Status != models.StatusActive {
+			continue
+		}
+		reason := completionReason(val, now)
+		if reason == "" {
+			continue
+		}
+		if err := r.transitionStatus(&val, models.SystemTransitions, models.SystemUserID, models.StatusCompleted, reason); err != nil {
+			return completed, err
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+func (r *memoryCampaignRepository) ChangeCampaignStatus(ctx context.Context, id string, ownerID int32, actorID int32, version int64, to string, reason string) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Error updating campaign status")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	campaign, err := r.campaign(id, false)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkOwner(campaign, ownerID); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkVersion(campaign, version); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := r.transitionStatus(&campaign, models.CampaignTransitions, actorID, to, reason); err != nil {
+		return models.CampaignDB{}, err
+	}
+	return campaign, nil
+}
+
+// ReviewCampaign lets a moderator approve (to active) or reject a campaign waiting for review.
+// Unlike ChangeCampaignStatus it does not require the caller to own the campaign.
+func (r *memoryCampaignRepository) ReviewCampaign(ctx context.Context, id string, moderatorID int32, version int64, to string, reason string) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Error updating campaign status")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	campaign, err := r.campaign(id, false)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkVersion(campaign, version); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if campaign.Status != models.StatusPendingReview {
+		return models.CampaignDB{}, newError(ErrInvalidTransition, "CAMPAIGN_NOT_PENDING_REVIEW", "campaign status is %v, only %v campaigns can be reviewed", campaign.Status, models.StatusPendingReview)
+	}
+	if err := checkTransition(&campaign, models.ModeratorTransitions, to); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// Keep the latest rejection reason on the campaign so the owner can act on it
+	campaign.RejectionReason = ""
+	if to == models.StatusRejected {
+		campaign.RejectionReason = reason
+	}
+	r.recordStatusChange(&campaign, moderatorID, to, reason)
+	r.campaigns[id] = campaign
+	return campaign, nil
+}
+
+// ForceCancelCampaign lets an admin cancel a campaign from any status except cancelled, bypassing
+// models.CampaignTransitions. The change is still written to the status history.
+func (r *memoryCampaignRepository) ForceCancelCampaign(ctx context.Context, id string, adminID int32, reason string) (models.CampaignDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignDB{}, dbError(err, "Error updating campaign status")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	campaign, err := r.campaign(id, false)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if campaign.Status == models.StatusCancelled {
+		return models.CampaignDB{}, invalidTransitionError(campaign.ID, models.CampaignTransitions, campaign.Status, models.StatusCancelled)
+	}
+
+	r.recordStatusChange(&campaign, adminID, models.StatusCancelled, reason)
+	r.campaigns[id] = campaign
+	return campaign, nil
+}
+
+// GetStatusHistory returns every status change of a campaign, oldest first. Deleted campaigns keep their history.
+func (r *memoryCampaignRepository) GetStatusHistory(ctx context.Context, id string) ([]models.CampaignStatusChangeDB, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, dbError(err, "Error reading status history")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var changes []models.CampaignStatusChangeDB
+	for _, val := range r.statusChanges {
+		if val.CampaignID == id {
+			changes = append(changes, val)
+		}
+	}
+	return changes, nil
+}
+
+// campaign returns a copy of the stored campaign. r.mu must be held.
+func (r *memoryCampaignRepository) campaign(id string, includeDeleted bool) (models.CampaignDB, error) {
+	campaign, ok := r.campaigns[id]
+	if !ok || (campaign.DeletedAt.Valid && !includeDeleted) {
+		return models.CampaignDB{}, newError(ErrNotFound, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+	}
+	return campaign, nil
+}
+
+// transitionStatus checks a status change against transitions and stores it. r.mu must be held for writing.
+func (r *memoryCampaignRepository) transitionStatus(campaign *models.CampaignDB, transitions models.StatusTransitions, userID int32, to string, reason string) error {
+	if err := checkTransition(campaign, transitions, to); err != nil {
+		return err
+	}
+	r.recordStatusChange(campaign, userID, to, reason)
+	r.campaigns[campaign.ID] = *campaign
+	return nil
+}
+
+// recordStatusChange moves campaign to status to, bumps its version and appends the change to the
+// status history. The caller stores campaign. r.mu must be held for writing.
+func (r *memoryCampaignRepository) recordStatusChange(campaign *models.CampaignDB, userID int32, to string, reason string) {
+	now := time.Now()
+	r.statusChanges = append(r.statusChanges, models.CampaignStatusChangeDB{
+		ID:         uint(len(r.statusChanges) + 1),
+		CampaignID: campaign.ID,
+		UserID:     userID,
+		FromStatus: campaign.Status,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedAt:  now,
+	})
+	campaign.Status = to
+	campaign.Version++
+	campaign.UpdatedAt = now
+}
+
+// matchingCampaigns returns copies of the campaigns accepted by visible and the filters of opts.
+// r.mu must be held.
+func (r *memoryCampaignRepository) matchingCampaigns(opts CampaignListOptions, visible func(models.CampaignDB) bool) []models.CampaignDB {
+	var campaigns []models.CampaignDB
+	for _, val := range r.campaigns {
+		if visible(val) && matchesCampaignFilters(val, opts) {
+			campaigns = append(campaigns, val)
+		}
+	}
+	return campaigns
+}
+
+// matchesCampaignFilters reports whether campaign passes the filters of opts, like applyCampaignFilters does in SQL
+func matchesCampaignFilters(campaign models.CampaignDB, opts CampaignListOptions) bool {
+	switch {
+	case len(opts.Statuses) > 0 && !slices.Contains(opts.Statuses, campaign.Status):
+		return false
+	case len(opts.Categories) > 0 && !slices.Contains(opts.Categories, campaign.Category):
+		return false
+	case opts.DeadlineFrom != nil && campaign.Deadline.Before(*opts.DeadlineFrom):
+		return false
+	case opts.DeadlineTo != nil && campaign.Deadline.After(*opts.DeadlineTo):
+		return false
+	case opts.Currency != "" && campaign.Currency != opts.Currency:
+		return false
+	case opts.MinTarget != nil && campaign.TargetAmount < *opts.MinTarget:
+		return false
+	case opts.MaxTarget != nil && campaign.TargetAmount > *opts.MaxTarget:
+		return false
+	}
+	return true
+}
+
+// pageCampaigns sorts campaigns by the sort key of opts and returns the page after cursor,
+// so page tokens behave exactly like the keyset pagination of the SQL implementation
+func pageCampaigns(campaigns []models.CampaignDB, opts CampaignListOptions, cursor *pageCursor) CampaignPage {
+	direction := 1
+	if opts.Descending {
+		direction = -1
+	}
+	sort.Slice(campaigns, func(i, j int) bool {
+		return direction*compareCursors(campaignCursor(campaigns[i], opts), campaignCursor(campaigns[j], opts)) < 0
+	})
+
+	// Continue after the last row of the previous page
+	if cursor != nil {
+		start := len(campaigns)
+		for i, val := range campaigns {
+			if direction*compareCursors(campaignCursor(val, opts), *cursor) > 0 {
+				start = i
+				break
+			}
+		}
+		campaigns = campaigns[start:]
+	}
+
+	pageSize := normalizePageSize(opts.PageSize)
+	page := CampaignPage{Campaigns: campaigns}
+	if len(campaigns) > pageSize {
+		page.Campaigns = campaigns[:pageSize]
+		page.NextPageToken = encodePageToken(campaignCursor(page.Campaigns[pageSize-1], opts))
+	}
+	return page
+}
+
+// compareCursors orders two positions by their sort key, then by id like the SQL row comparison
+func compareCursors(a pageCursor, b pageCursor) int {
+	if a.Time != nil && b.Time != nil {
+		if c := a.Time.Compare(*b.Time); c != 0 {
+			return c
+		}
+	} else if a.Integer != nil && b.Integer != nil {
+		if c := cmp.Compare(*a.Integer, *b.Integer); c != 0 {
+			return c
+		}
+	} else if a.Number != nil && b.Number != nil {
+		if c := cmp.Compare(*a.Number, *b.Number); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(a.ID, b.ID)
+}
@@ -0,0 +1,783 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// Sort keys accepted by ListCampaigns mapped to the document field, percent_funded is computed by the pipeline
+var campaignSortFields = map[string]string{
+	"created_at":       "created_at",
+	"deadline":         "deadline",
+	"collected_amount": "collected_amount",
+	"percent_funded":   "percent_funded",
+}
+
+// mongoCampaignRepository is the MongoDB implementation of CampaignRepository. Single document
+// writes are conditional on the version or amounts read before, ledger entries that span
+// collections are written first and removed again when the campaign rejects them. Status changes
+// and their history entry use a transaction where the deployment has them, see saveStatusChange.
+type mongoCampaignRepository struct {
+	client        *mongo.Client
+	campaigns     *mongo.Collection
+	contributions *mongo.Collection
+	reversals     *mongo.Collection
+	statusChanges *mongo.Collection
+
+	// transactions caches whether the deployment supports multi-document transactions
+	transactionsMu sync.Mutex
+	transactions   *bool
+}
+
+// Constructor NewMongoCampaignRepository creates and returns a new instance of mongoCampaignRepository,
+// injecting the Mongo database. Call EnsureMongoIndexes once before using it.
+func NewMongoCampaignRepository(db *mongo.Database) CampaignRepository {
+	return &mongoCampaignRepository{
+		client:        db.Client(),
+		campaigns:     db.Collection(campaignsCollection),
+		contributions: db.Collection(contributionsCollection),
+		reversals:     db.Collection(reversalsCollection),
+		statusChanges: db.Collection(statusChangesCollection),
+	}
+}
+
+func (r *mongoCampaignRepository) CreateCampaign(ctx context.Context, campaign models.CampaignDB) (models.CampaignDB, error) {
+	applyCampaignDefaults(&campaign, time.Now())
+	document := newCampaignDocument(campaign)
+	if _, err := r.campaigns.InsertOne(ctx, document); err != nil {
+		return models.CampaignDB{}, mongoError(err, "Failed to create a campaign")
+	}
+	return document.model(), nil
+}
+
+func (r *mongoCampaignRepository) GetCampaignByID(ctx context.Context, id string) (models.CampaignDB, error) {
+	return r.findCampaign(ctx, bson.M{"_id": id, "deleted_at": nil})
+}
+
+func (r *mongoCampaignRepository) DeleteCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, version int64) error {
+	campaign, err := r.findCampaign(ctx, bson.M{"_id": id, "deleted_at": nil})
+	if err != nil {
+		return err
+	}
+	if err := checkOwner(campaign, ownerID); err != nil {
+		return err
+	}
+	if err := checkVersion(campaign, version); err != nil {
+		return err
+	}
+
+	// Update status to "cancelled" through the status rules, then delete data
+	if err := checkTransition(&campaign, models.CampaignTransitions, models.StatusCancelled); err != nil {
+		return err
+	}
+	deleted := campaign
+	deleted.Status = models.StatusCancelled
+	deleted.Version++
+	deleted.DeletedAt.Time, deleted.DeletedAt.Valid = time.Now(), true
+	return r.saveStatusChange(ctx, campaign, deleted, actorID, "deleted", "status", "deleted_at")
+}
+
+// UpdateCampaignByID writes exactly the given columns of campaign, including zero values.
+// campaign.Version is the version the caller expects the stored campaign to have.
+func (r *mongoCampaignRepository) UpdateCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, campaign models.CampaignDB, fields []string) (models.CampaignDB, error) {
+	retreivedCampaign, err := r.findCampaign(ctx, bson.M{"_id": id, "deleted_at": nil})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkOwner(retreivedCampaign, ownerID); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkVersion(retreivedCampaign, campaign.Version); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	if err := currencyChangeError(retreivedCampaign, campaign, fields); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// check if current status cancelled, completed or under review
+	if retreivedCampaign.Status == models.StatusCancelled || retreivedCampaign.Status == models.StatusCompleted || retreivedCampaign.Status == models.StatusPendingReview {
+		return models.CampaignDB{}, newError(ErrPrecondition, "CAMPAIGN_NOT_EDITABLE", "campaign status is %v", retreivedCampaign.Status)
+	}
+
+	if err := minDonationError(retreivedCampaign, campaign, fields); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// Status changes follow the same rules as the dedicated status RPCs, checked against the stored campaign
+	updated := retreivedCampaign
+	var columns []string
+	statusChanged := false
+	for _, field := range fields {
+		if field != "status" {
+			setter, ok := campaignColumnSetters[field]
+			if !ok {
+				return models.CampaignDB{}, newError(ErrInvalidArgument, "UNKNOWN_FIELD", "Unknown campaign field %v", field)
+			}
+			setter(&updated, campaign)
+			columns = append(columns, field)
+		} else if campaign.Status != retreivedCampaign.Status {
+			if err := checkTransition(&retreivedCampaign, models.CampaignTransitions, campaign.Status); err != nil {
+				return models.CampaignDB{}, err
+			}
+			updated.Status = campaign.Status
+			updated.Version++
+			columns = append(columns, "status")
+			statusChanged = true
+		}
+	}
+	if len(columns) == 0 {
+		return retreivedCampaign, nil
+	}
+	if len(columns) > 1 || !statusChanged {
+		updated.Version++
+	}
+
+	if statusChanged {
+		err = r.saveStatusChange(ctx, retreivedCampaign, updated, actorID, "updated", columns...)
+	} else {
+		err = r.saveCampaign(ctx, retreivedCampaign, updated, columns...)
+	}
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	return r.GetCampaignByID(ctx, id)
+}
+
+func (r *mongoCampaignRepository) GetCampaignsByUserID(ctx context.Context, userID int32, opts CampaignListOptions) (CampaignPage, error) {
+	filter := campaignFilter(opts)
+	filter["user_id"] = userID
+	filter["deleted_at"] = nil
+
+	// Count every matching campaign of the user regardless of the page
+	total, err := r.campaigns.CountDocuments(ctx, filter)
+	if err != nil {
+		return CampaignPage{}, mongoError(err, "Error counting campaigns")
+	}
+
+	page, err := r.listCampaigns(ctx, filter, opts)
+	if err != nil {
+		return CampaignPage{}, err
+	}
+	page.TotalCount = total
+	return page, nil
+}
+
+func (r *mongoCampaignRepository) ListCampaigns(ctx context.Context, opts CampaignListOptions) (CampaignPage, error) {
+	// Drafts are only visible to their owner
+	if len(opts.Statuses) == 0 {
+		opts.Statuses = models.PublicStatuses
+	}
+	filter := campaignFilter(opts)
+	if !opts.IncludeDeleted {
+		filter["deleted_at"] = nil
+	}
+	return r.listCampaigns(ctx, filter, opts)
+}
+
+// listCampaigns returns one page of campaigns matching filter and opts using keyset pagination
+func (r *mongoCampaignRepository) listCampaigns(ctx context.Context, filter bson.M, opts CampaignListOptions) (CampaignPage, error) {
+	cursor, err := listCursor(&opts)
+	if err != nil {
+		return CampaignPage{}, err
+	}
+	sortField := campaignSortFields[opts.SortBy]
+
+	direction, comparator := 1, "$gt"
+	if opts.Descending {
+		direction, comparator = -1, "$lt"
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$addFields", Value: bson.M{"percent_funded": bson.M{"$cond": bson.A{
+			bson.M{"$gt": bson.A{"$target_amount", 0}},
+			bson.M{"$divide": bson.A{"$collected_amount", "$target_amount"}},
+			0.0,
+		}}}}},
+	}
+
+	// Continue after the last document of the previous page (keyset pagination)
+	if cursor != nil {
+		value := cursor.sortValue()
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{sortField: bson.M{comparator: value}},
+			bson.M{sortField: value, "_id": bson.M{comparator: cursor.ID}},
+		}}}})
+	}
+
+	// Fetch one extra document to know whether there is a next page
+	pageSize := normalizePageSize(opts.PageSize)
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: sortField, Value: direction}, {Key: "_id", Value: direction}}}},
+		bson.D{{Key: "$limit", Value: pageSize + 1}},
+	)
+
+	campaigns, err := r.aggregateCampaigns(ctx, pipeline)
+	if err != nil {
+		return CampaignPage{}, err
+	}
+
+	page := CampaignPage{Campaigns: campaigns}
+	if len(campaigns) > pageSize {
+		page.Campaigns = campaigns[:pageSize]
+		page.NextPageToken = encodePageToken(campaignCursor(page.Campaigns[pageSize-1], opts))
+	}
+	return page, nil
+}
+
+// SearchCampaigns narrows the campaigns down with a case insensitive match of every term,
+// then ranks and highlights them like the fallback search of the SQL implementation
+func (r *mongoCampaignRepository) SearchCampaigns(ctx context.Context, opts CampaignSearchOptions) (CampaignSearchPage, error) {
+	terms, cursor, err := prepareSearch(&opts)
+	if err != nil {
+		return CampaignSearchPage{}, err
+	}
+
+	filter := campaignFilter(CampaignListOptions{Statuses: opts.Statuses, Categories: opts.Categories})
+	filter["deleted_at"] = nil
+	var matches bson.A
+	for _, term := range terms {
+		pattern := bson.M{"$regex": regexp.QuoteMeta(term), "$options": "i"}
+		matches = append(matches, bson.M{"$or": bson.A{bson.M{"title": pattern}, bson.M{"description": pattern}}})
+	}
+	filter["$and"] = matches
+
+	campaigns, err := r.aggregateCampaigns(ctx, mongo.Pipeline{{{Key: "$match", Value: filter}}})
+	if err != nil {
+		return CampaignSearchPage{}, err
+	}
+	return rankSearchResults(campaigns, terms, cursor, normalizePageSize(opts.PageSize)), nil
+}
+
+func (r *mongoCampaignRepository) RecordContribution(ctx context.Context, contribution models.CampaignContributionDB) (models.CampaignDB, error) {
+	now := time.Now()
+	if contribution.CreatedAt.IsZero() {
+		contribution.CreatedAt = now
+	}
+
+	// Add the ledger entry, a contribution can only be credited once
+	_, err := r.contributions.InsertOne(ctx, contributionDocument{
+		ID:         contribution.ID,
+		CampaignID: contribution.CampaignID,
+		Currency:   contribution.Currency,
+		Amount:     contribution.Amount,
+		Source:     contribution.Source,
+		CreatedAt:  bsonTime(contribution.CreatedAt),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return models.CampaignDB{}, newError(ErrAlreadyExists, "CONTRIBUTION_ALREADY_RECORDED", "contribution %v was already recorded", contribution.ID)
+	}
+	if err != nil {
+		return models.CampaignDB{}, mongoError(err, "Error recording contribution")
+	}
+
+	// Increment in a single update so concurrent donations never lose updates.
+	// Only a status change bumps the version, donations alone keep etags valid.
+	campaign, err := r.updateCampaign(ctx, bson.M{
+		"_id":          contribution.CampaignID,
+		"deleted_at":   nil,
+		"status":       models.StatusActive,
+		"deadline":     bson.M{"$gt": now},
+		"currency":     contribution.Currency,
+		"min_donation": bson.M{"$lte": contribution.Amount},
+	}, bson.M{
+		"$inc": bson.M{"collected_amount": contribution.Amount},
+		"$set": bson.M{"updated_at": now},
+	})
+	if err != nil {
+		undoMongoWrite(ctx, "contribution "+contribution.ID, func(ctx context.Context) error {
+			_, err := r.contributions.DeleteOne(ctx, bson.M{"_id": contribution.ID})
+			return err
+		})
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return models.CampaignDB{}, mongoError(err, "Error recording contribution")
+		}
+
+		// Nothing was updated, find out which rule rejected the contribution
+		stored, err := r.GetCampaignByID(ctx, contribution.CampaignID)
+		if err != nil {
+			return models.CampaignDB{}, err
+		}
+		if err := contributionError(stored, contribution); err != nil {
+			return models.CampaignDB{}, err
+		}
+		return models.CampaignDB{}, newError(ErrConflict, "CONCURRENT_MODIFICATION", "campaign changed while recording contribution, please retry")
+	}
+
+	// Complete the campaign right away when it opted in and reached its target
+	if campaign.AutoCompleteOnTarget && campaign.CollectedAmount >= campaign.TargetAmount {
+		return r.systemTransition(ctx, campaign, models.StatusCompleted, "target reached")
+	}
+	return campaign, nil
+}
+
+func (r *mongoCampaignRepository) ReverseContribution(ctx context.Context, reversal models.CampaignReversalDB) (models.CampaignDB, error) {
+	var contribution contributionDocument
+	if err := r.contributions.FindOne(ctx, bson.M{"_id": reversal.ContributionID, "campaign_id": reversal.CampaignID}).Decode(&contribution); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.CampaignDB{}, newError(ErrNotFound, "CONTRIBUTION_NOT_FOUND", "contribution %v not found for campaign", reversal.ContributionID)
+		}
+		return models.CampaignDB{}, mongoError(err, "Error reading contribution")
+	}
+	if contribution.Currency != reversal.Currency {
+		return models.CampaignDB{}, newError(ErrInvalidArgument, "CURRENCY_MISMATCH", "contribution currency is %v, got %v", contribution.Currency, reversal.Currency)
+	}
+
+	// Keep the reversal in the ledger, it is removed again if the campaign rejects it
+	now := time.Now()
+	if reversal.CreatedAt.IsZero() {
+		reversal.CreatedAt = now
+	}
+	_, err := r.reversals.InsertOne(ctx, reversalDocument{
+		ID:             reversal.ID,
+		CampaignID:     reversal.CampaignID,
+		ContributionID: reversal.ContributionID,
+		Currency:       reversal.Currency,
+		Amount:         reversal.Amount,
+		Reason:         reversal.Reason,
+		CreatedAt:      bsonTime(reversal.CreatedAt),
+	})
+	if err != nil {
+		return models.CampaignDB{}, mongoError(err, "Error recording reversal")
+	}
+	undoReversal := func() {
+		undoMongoWrite(ctx, "reversal "+reversal.ID, func(ctx context.Context) error {
+			_, err := r.reversals.DeleteOne(ctx, bson.M{"_id": reversal.ID})
+			return err
+		})
+	}
+
+	// A contribution cannot be reversed for more than it was worth. The check and the increment are
+	// one update so parallel refunds of the same contribution cannot both pass.
+	result, err := r.contributions.UpdateOne(ctx,
+		bson.M{"_id": contribution.ID, "reversed_amount": bson.M{"$lte": contribution.Amount - reversal.Amount}},
+		bson.M{"$inc": bson.M{"reversed_amount": reversal.Amount}})
+	if err != nil {
+		undoReversal()
+		return models.CampaignDB{}, mongoError(err, "Error reversing contribution")
+	}
+	if result.MatchedCount == 0 {
+		undoReversal()
+		if err := r.contributions.FindOne(ctx, bson.M{"_id": contribution.ID}).Decode(&contribution); err != nil {
+			return models.CampaignDB{}, mongoError(err, "Error reading contribution")
+		}
+		return models.CampaignDB{}, newError(ErrPrecondition, "REVERSAL_EXCEEDS_CONTRIBUTION", "reversal exceeds the remaining contribution amount of %v", contribution.Amount-contribution.ReversedAmount)
+	}
+
+	// Decrement without going below zero, cancelled (soft deleted) campaigns can still be refunded
+	campaign, err := r.updateCampaign(ctx, bson.M{
+		"_id":              reversal.CampaignID,
+		"currency":         reversal.Currency,
+		"collected_amount": bson.M{"$gte": reversal.Amount},
+	}, bson.M{
+		"$inc": bson.M{"collected_amount": -reversal.Amount},
+		"$set": bson.M{"updated_at": now},
+	})
+	if err != nil {
+		undoReversal()
+		undoMongoWrite(ctx, "reversed amount of contribution "+contribution.ID, func(ctx context.Context) error {
+			_, err := r.contributions.UpdateOne(ctx, bson.M{"_id": contribution.ID}, bson.M{"$inc": bson.M{"reversed_amount": -reversal.Amount}})
+			return err
+		})
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return models.CampaignDB{}, mongoError(err, "Error reversing contribution")
+		}
+
+		// Nothing was updated, find out which rule rejected the reversal
+		stored, err := r.findCampaign(ctx, bson.M{"_id": reversal.CampaignID})
+		if err != nil {
+			return models.CampaignDB{}, err
+		}
+		if err := reversalError(stored, reversal); err != nil {
+			return models.CampaignDB{}, err
+		}
+		return models.CampaignDB{}, newError(ErrConflict, "CONCURRENT_MODIFICATION", "campaign changed while reversing contribution, please retry")
+	}
+
+	// Re-open a completed campaign that falls under its target
+	if campaign.Status == models.StatusCompleted && campaign.CollectedAmount < campaign.TargetAmount && campaign.Deadline.After(now) {
+		return r.systemTransition(ctx, campaign, models.StatusActive, "reversal dropped below target")
+	}
+	return campaign, nil
+}
+
+func (r *mongoCampaignRepository) ReconcileCampaign(ctx context.Context, id string, fix bool) (CampaignReconciliation, error) {
+	campaign, err := r.findCampaign(ctx, bson.M{"_id": id})
+	if err != nil {
+		return CampaignReconciliation{}, err
+	}
+
+	// collected_amount = contributions - reversals
+	contributed, err := sumAmounts(ctx, r.contributions, id)
+	if err != nil {
+		return CampaignReconciliation{}, mongoError(err, "Error summing contributions")
+	}
+	reversed, err := sumAmounts(ctx, r.reversals, id)
+	if err != nil {
+		return CampaignReconciliation{}, mongoError(err, "Error summing reversals")
+	}
+
+	reconciliation := CampaignReconciliation{
+		Campaign:     campaign,
+		LedgerAmount: contributed - reversed,
+	}
+	reconciliation.Drift = campaign.CollectedAmount - reconciliation.LedgerAmount
+	if !fix || reconciliation.Drift == 0 {
+		return reconciliation, nil
+	}
+
+	// Reset the stored amount to what the ledger says, unless a contribution landed since it was read
+	fixed, err := r.updateCampaign(ctx,
+		bson.M{"_id": id, "collected_amount": campaign.CollectedAmount},
+		bson.M{"$set": bson.M{"collected_amount": reconciliation.LedgerAmount, "updated_at": time.Now()}})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return CampaignReconciliation{}, newError(ErrConflict, "CONCURRENT_MODIFICATION", "campaign changed while reconciling, please retry")
+	}
+	if err != nil {
+		return CampaignReconciliation{}, mongoError(err, "Error fixing collected amount")
+	}
+	reconciliation.Campaign = fixed
+	reconciliation.Fixed = true
+	return reconciliation, nil
+}
+
+// CompleteDueCampaigns marks active campaigns as completed once their deadline has passed, or once they
+// reached their target when AutoCompleteOnTarget is set. Every change is conditional on the version
+// that was read, so replicas running it at the same time never complete a campaign twice.
+func (r *mongoCampaignRepository) CompleteDueCampaigns(ctx context.Context, now time.Time) (int64, error) {
+	campaigns, err := r.aggregateCampaigns(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"status":     models.StatusActive,
+			"deleted_at": nil,
+			"$or": bson.A{
+				bson.M{"deadline": bson.M{"$lte": now}},
+				bson.M{"auto_complete_on_target": true, "$expr": bson.M{"$gte": bson.A{"$collected_amount", "$target_amount"}}},
+			},
+		}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var completed int64
+	for _, val := range campaigns {
+		if err := checkTransition(&val, models.SystemTransitions, models.StatusCompleted); err != nil {
+			return completed, err
+		}
+		_, err := r.changeStatus(ctx, val, val, models.SystemUserID, models.StatusCompleted, completionReason(val, now))
+		if errors.Is(err, ErrConflict) {
+			// Changed since it was read, another replica or the owner got there first
+			continue
+		}
+		if err != nil {
+			return completed, err
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+func (r *mongoCampaignRepository) ChangeCampaignStatus(ctx context.Context, id string, ownerID int32, actorID int32, version int64, to string, reason string) (models.CampaignDB, error) {
+	campaign, err := r.findCampaign(ctx, bson.M{"_id": id, "deleted_at": nil})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkOwner(campaign, ownerID); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkVersion(campaign, version); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkTransition(&campaign, models.CampaignTransitions, to); err != nil {
+		return models.CampaignDB{}, err
+	}
+	return r.changeStatus(ctx, campaign, campaign, actorID, to, reason)
+}
+
+// ReviewCampaign lets a moderator approve (to active) or reject a campaign waiting for review.
+// Unlike ChangeCampaignStatus it does not require the caller to own the campaign.
+func (r *mongoCampaignRepository) ReviewCampaign(ctx context.Context, id string, moderatorID int32, version int64, to string, reason string) (models.CampaignDB, error) {
+	campaign, err := r.findCampaign(ctx, bson.M{"_id": id, "deleted_at": nil})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if err := checkVersion(campaign, version); err != nil {
+		return models.CampaignDB{}, err
+	}
+	if campaign.Status != models.StatusPendingReview {
+		return models.CampaignDB{}, newError(ErrInvalidTransition, "CAMPAIGN_NOT_PENDING_REVIEW", "campaign status is %v, only %v campaigns can be reviewed", campaign.Status, models.StatusPendingReview)
+	}
+	if err := checkTransition(&campaign, models.ModeratorTransitions, to); err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// Keep the latest rejection reason on the campaign so the owner can act on it
+	reviewed := campaign
+	reviewed.RejectionReason = ""
+	if to == models.StatusRejected {
+		reviewed.RejectionReason = reason
+	}
+	return r.changeStatus(ctx, campaign, reviewed, moderatorID, to, reason, "rejection_reason")
+}
+
+// ForceCancelCampaign lets an admin cancel a campaign from any status except cancelled, bypassing
+// models.CampaignTransitions. The change is still written to the status history.
+func (r *mongoCampaignRepository) ForceCancelCampaign(ctx context.Context, id string, adminID int32, reason string) (models.CampaignDB, error) {
+	campaign, err := r.findCampaign(ctx, bson.M{"_id": id, "deleted_at": nil})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if campaign.Status == models.StatusCancelled {
+		return models.CampaignDB{}, invalidTransitionError(campaign.ID, models.CampaignTransitions, campaign.Status, models.StatusCancelled)
+	}
+	return r.changeStatus(ctx, campaign, campaign, adminID, models.StatusCancelled, reason)
+}
+
+// GetStatusHistory returns every status change of a campaign, oldest first. Deleted campaigns keep their history.
+func (r *mongoCampaignRepository) GetStatusHistory(ctx context.Context, id string) ([]models.CampaignStatusChangeDB, error) {
+	cursor, err := r.statusChanges.Find(ctx, bson.M{"campaign_id": id}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, mongoError(err, "Error reading status history")
+	}
+	var documents []statusChangeDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, mongoError(err, "Error reading status history")
+	}
+
+	var changes []models.CampaignStatusChangeDB
+	for _, val := range documents {
+		changes = append(changes, val.model())
+	}
+	return changes, nil
+}
+
+// systemTransition moves campaign to status to on behalf of the service itself. When the campaign
+// changed since it was read it is returned as stored, the change is then left to whoever changed it.
+func (r *mongoCampaignRepository) systemTransition(ctx context.Context, campaign models.CampaignDB, to string, reason string) (models.CampaignDB, error) {
+	if err := checkTransition(&campaign, models.SystemTransitions, to); err != nil {
+		return models.CampaignDB{}, err
+	}
+	changed, err := r.changeStatus(ctx, campaign, campaign, models.SystemUserID, to, reason)
+	if errors.Is(err, ErrConflict) {
+		return r.findCampaign(ctx, bson.M{"_id": campaign.ID})
+	}
+	return changed, err
+}
+
+// changeStatus moves changed to status to and writes it along with the extra fields, provided the
+// stored campaign still has the version of campaign. The change is appended to the status history.
+func (r *mongoCampaignRepository) changeStatus(ctx context.Context, campaign models.CampaignDB, changed models.CampaignDB, userID int32, to string, reason string, fields ...string) (models.CampaignDB, error) {
+	changed.Status = to
+	changed.Version = campaign.Version + 1
+	if err := r.saveStatusChange(ctx, campaign, changed, userID, reason, append(fields, "status")...); err != nil {
+		return models.CampaignDB{}, err
+	}
+	return r.findCampaign(ctx, bson.M{"_id": campaign.ID})
+}
+
+// saveCampaign writes the given fields of updated, its version and update time, if the stored campaign
+// still has the version of campaign. Amounts are never part of fields, so donations that landed since
+// campaign was read are kept.
+func (r *mongoCampaignRepository) saveCampaign(ctx context.Context, campaign models.CampaignDB, updated models.CampaignDB, fields ...string) error {
+	updated.UpdatedAt = time.Now()
+	set, err := newCampaignDocument(updated).fields(append(fields, "version", "updated_at")...)
+	if err != nil {
+		return mongoError(err, "Error updating campaign")
+	}
+
+	result, err := r.campaigns.UpdateOne(ctx, bson.M{"_id": campaign.ID, "version": campaign.Version}, bson.M{"$set": set})
+	if err != nil {
+		return mongoError(err, "Error updating campaign")
+	}
+	if result.MatchedCount == 0 {
+		return newError(ErrConflict, "CONCURRENT_MODIFICATION", "Campaign changed while updating, please retry")
+	}
+	return nil
+}
+
+// saveStatusChange saves the fields of updated like saveCampaign and appends the change from the
+// status of campaign to the one of updated to the status history, both or neither. Replica sets
+// and sharded clusters write them in a transaction. Standalone servers have none, so the history
+// entry is written first and removed again when the campaign cannot be saved, like ledger entries.
+func (r *mongoCampaignRepository) saveStatusChange(ctx context.Context, campaign models.CampaignDB, updated models.CampaignDB, userID int32, reason string, fields ...string) error {
+	if r.supportsTransactions(ctx) {
+		session, err := r.client.StartSession()
+		if err != nil {
+			return mongoError(err, "Error starting session")
+		}
+		defer session.EndSession(ctx)
+
+		_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+			if err := r.saveCampaign(ctx, campaign, updated, fields...); err != nil {
+				return nil, err
+			}
+			_, err := r.recordStatusChange(ctx, campaign, userID, updated.Status, reason)
+			return nil, err
+		})
+		var repoErr *Error
+		if err != nil && !errors.As(err, &repoErr) {
+			return mongoError(err, "Error committing status change")
+		}
+		return err
+	}
+
+	changeID, err := r.recordStatusChange(ctx, campaign, userID, updated.Status, reason)
+	if err != nil {
+		return err
+	}
+	if err := r.saveCampaign(ctx, campaign, updated, fields...); err != nil {
+		undoMongoWrite(ctx, "status change of campaign "+campaign.ID, func(ctx context.Context) error {
+			_, err := r.statusChanges.DeleteOne(ctx, bson.M{"_id": changeID})
+			return err
+		})
+		return err
+	}
+	return nil
+}
+
+// supportsTransactions reports whether the deployment is a replica set or a sharded cluster, the
+// ones that run multi-document transactions. The answer is cached once the server replied.
+func (r *mongoCampaignRepository) supportsTransactions(ctx context.Context) bool {
+	r.transactionsMu.Lock()
+	defer r.transactionsMu.Unlock()
+	if r.transactions != nil {
+		return *r.transactions
+	}
+
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := r.campaigns.Database().RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		log.Printf("Failed to detect MongoDB topology, writing without transactions: %v", err)
+		return false
+	}
+	supported := hello.SetName != "" || hello.Msg == "isdbgrid"
+	r.transactions = &supported
+	return supported
+}
+
+// recordStatusChange appends a status change of campaign to the status history and returns the id
+// of the new entry
+func (r *mongoCampaignRepository) recordStatusChange(ctx context.Context, campaign models.CampaignDB, userID int32, to string, reason string) (interface{}, error) {
+	result, err := r.statusChanges.InsertOne(ctx, statusChangeDocument{
+		CampaignID: campaign.ID,
+		UserID:     userID,
+		FromStatus: campaign.Status,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedAt:  bsonTime(time.Now()),
+	})
+	if err != nil {
+		return nil, mongoError(err, "Error recording status change")
+	}
+	return result.InsertedID, nil
+}
+
+// findCampaign returns the campaign matching filter
+func (r *mongoCampaignRepository) findCampaign(ctx context.Context, filter bson.M) (models.CampaignDB, error) {
+	var document campaignDocument
+	if err := r.campaigns.FindOne(ctx, filter).Decode(&document); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.CampaignDB{}, newError(ErrNotFound, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		return models.CampaignDB{}, mongoError(err, "Error reading campaign")
+	}
+	return document.model(), nil
+}
+
+// updateCampaign applies update to the campaign matching filter and returns it as written.
+// It returns mongo.ErrNoDocuments when nothing matched.
+func (r *mongoCampaignRepository) updateCampaign(ctx context.Context, filter bson.M, update interface{}) (models.CampaignDB, error) {
+	var document campaignDocument
+	err := r.campaigns.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	return document.model(), nil
+}
+
+// aggregateCampaigns runs pipeline on the campaigns collection
+func (r *mongoCampaignRepository) aggregateCampaigns(ctx context.Context, pipeline mongo.Pipeline) ([]models.CampaignDB, error) {
+	cursor, err := r.campaigns.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, mongoError(err, "Error listing campaigns")
+	}
+	var documents []campaignDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, mongoError(err, "Error listing campaigns")
+	}
+
+	var campaigns []models.CampaignDB
+	for _, val := range documents {
+		campaigns = append(campaigns, val.model())
+	}
+	return campaigns, nil
+}
+
+// campaignFilter builds the query for the filters of opts, like applyCampaignFilters does in SQL
+func campaignFilter(opts CampaignListOptions) bson.M {
+	filter := bson.M{}
+	if len(opts.Statuses) > 0 {
+		filter["status"] = bson.M{"$in": opts.Statuses}
+	}
+	if len(opts.Categories) > 0 {
+		filter["category"] = bson.M{"$in": opts.Categories}
+	}
+	deadline := bson.M{}
+	if opts.DeadlineFrom != nil {
+		deadline["$gte"] = *opts.DeadlineFrom
+	}
+	if opts.DeadlineTo != nil {
+		deadline["$lte"] = *opts.DeadlineTo
+	}
+	if len(deadline) > 0 {
+		filter["deadline"] = deadline
+	}
+	if opts.Currency != "" {
+		filter["currency"] = opts.Currency
+	}
+	target := bson.M{}
+	if opts.MinTarget != nil {
+		target["$gte"] = *opts.MinTarget
+	}
+	if opts.MaxTarget != nil {
+		target["$lte"] = *opts.MaxTarget
+	}
+	if len(target) > 0 {
+		filter["target_amount"] = target
+	}
+	return filter
+}
+
+// sumAmounts adds up the amount of every ledger entry of a campaign in collection
+func sumAmounts(ctx context.Context, collection *mongo.Collection, campaignID string) (int64, error) {
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"campaign_id": campaignID}}},
+		{{Key: "$group", Value: bson.M{"_id": nil, "total": bson.M{"$sum": "$amount"}}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var rows []struct {
+		Total int64 `bson:"total"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return rows[0].Total, nil
+}
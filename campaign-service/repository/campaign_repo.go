@@ -1,22 +1,75 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"time"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
 )
 
 // CampaignRepository defines methods for interacting with campaign-related data in the database.
+// Mutations made on behalf of the owner take the owner the campaign must belong to and the user
+// acting on it separately, an admin acting for the owner is recorded as the actor in the history.
 type CampaignRepository interface {
-	CreateCampaign(campaign models.CampaignDB) (interface{}, error)
-	GetCampaignByID(campaignID string) (interface{}, error)
-	DeleteCampaignByID(id string) error
-	UpdateCampaignByID(id string, userID int32, campaign models.CampaignDB) (interface{}, error)
-	GetCampaignsByUserID(userID int32) (interface{}, error)
+	CreateCampaign(ctx context.Context, campaign models.CampaignDB) (models.CampaignDB, error)
+	GetCampaignByID(ctx context.Context, campaignID string) (models.CampaignDB, error)
+	DeleteCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, version int64) error
+	UpdateCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, campaign models.CampaignDB, fields []string) (models.CampaignDB, error)
+	GetCampaignsByUserID(ctx context.Context, userID int32, opts CampaignListOptions) (CampaignPage, error)
+	ListCampaigns(ctx context.Context, opts CampaignListOptions) (CampaignPage, error)
+	SearchCampaigns(ctx context.Context, opts CampaignSearchOptions) (CampaignSearchPage, error)
+	RecordContribution(ctx context.Context, contribution models.CampaignContributionDB) (models.CampaignDB, error)
+	ReverseContribution(ctx context.Context, reversal models.CampaignReversalDB) (models.CampaignDB, error)
+	ReconcileCampaign(ctx context.Context, id string, fix bool) (CampaignReconciliation, error)
+	CompleteDueCampaigns(ctx context.Context, now time.Time) (int64, error)
+	ChangeCampaignStatus(ctx context.Context, id string, ownerID int32, actorID int32, version int64, to string, reason string) (models.CampaignDB, error)
+	ReviewCampaign(ctx context.Context, id string, moderatorID int32, version int64, to string, reason string) (models.CampaignDB, error)
+	ForceCancelCampaign(ctx context.Context, id string, adminID int32, reason string) (models.CampaignDB, error)
+	GetStatusHistory(ctx context.Context, id string) ([]models.CampaignStatusChangeDB, error)
+}
+
+// CampaignListOptions holds the filters, ordering and paging used by ListCampaigns.
+// Zero values mean "no filter".
+type CampaignListOptions struct {
+	Statuses     []string
+	Categories   []string
+	DeadlineFrom *time.Time
+	DeadlineTo   *time.Time
+	Currency     string
+	MinTarget    *int64
+	MaxTarget    *int64
+	SortBy       string
+	Descending   bool
+	PageSize     int
+	PageToken    string
+	// IncludeDeleted also lists soft deleted campaigns, only honoured by ListCampaigns
+	IncludeDeleted bool
+}
+
+// CampaignPage is a single page of campaigns and the token to fetch the next one.
+// NextPageToken is empty on the last page. TotalCount is only filled by queries
+// that report it.
+type CampaignPage struct {
+	Campaigns     []models.CampaignDB
+	NextPageToken string
+	TotalCount    int64
+}
+
+// completionLockKey is the Postgres advisory lock held while completing campaigns,
+// so only one replica runs the completion job at a time
+const completionLockKey = 7200451
+
+// Sort keys accepted by ListCampaigns mapped to their SQL expression
+var campaignSortColumns = map[string]string{
+	"created_at":       "created_at",
+	"deadline":         "deadline",
+	"collected_amount": "collected_amount",
+	"percent_funded":   "CASE WHEN target_amount > 0 THEN CAST(collected_amount AS DOUBLE PRECISION) / target_amount ELSE 0 END",
 }
 
 // campaignRepository is the concrete implementation of CampaignRepository.
@@ -31,76 +84,357 @@ func NewCampaignRepository(db *gorm.DB) CampaignRepository {
 	return &campaignRepository{db: db}
 }
 
-func (r *campaignRepository) CreateCampaign(campaign models.CampaignDB) (interface{}, error) {
+func (r *campaignRepository) CreateCampaign(ctx context.Context, campaign models.CampaignDB) (models.CampaignDB, error) {
 	// Insert campaign to campaigns schema and campaigns table
-	result := r.db.Create(&campaign)
+	result := r.db.WithContext(ctx).Create(&campaign)
+	if result.Error != nil {
+		return models.CampaignDB{}, dbError(result.Error, "Failed to create a campaign")
+	}
 	if result.RowsAffected == 0 {
-		return nil, status.Error(codes.Internal, "Failed to create a campaign")
+		return models.CampaignDB{}, newError(ErrInternal, "INTERNAL", "Failed to create a campaign")
 	}
 
 	return campaign, nil
 }
 
-func (r *campaignRepository) GetCampaignByID(id string) (interface{}, error) {
+func (r *campaignRepository) GetCampaignByID(ctx context.Context, id string) (models.CampaignDB, error) {
 	var campaign models.CampaignDB
 	// Get campaign by id where deleted_at != nil
-	if err := r.db.First(&campaign, "id=?", id).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "Campaign not found")
+	if err := r.db.WithContext(ctx).First(&campaign, "id=?", id).Error; err != nil {
+		return models.CampaignDB{}, notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
 	}
 	return campaign, nil
 }
 
-func (r *campaignRepository) DeleteCampaignByID(id string) error {
-	// Check if campaign exist in table
-	_, err := r.GetCampaignByID(id)
+func (r *campaignRepository) DeleteCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, version int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Check if campaign exist in table
+		var campaign models.CampaignDB
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&campaign, "id=?", id).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		if err := checkOwner(campaign, ownerID); err != nil {
+			return err
+		}
+		if err := checkVersion(campaign, version); err != nil {
+			return err
+		}
+
+		// Update status to "cancelled" through the status rules, then delete data
+		if err := transitionStatus(tx, &campaign, models.CampaignTransitions, actorID, models.StatusCancelled, "deleted"); err != nil {
+			return err
+		}
+		result := tx.Where("user_id=?", ownerID).Delete(&campaign)
+		if result.Error != nil {
+			return dbError(result.Error, "Error deleting campaign")
+		}
+		if result.RowsAffected == 0 {
+			return newError(ErrConflict, "CONCURRENT_MODIFICATION", "Campaign changed while deleting, please retry")
+		}
+		return nil
+	})
+}
+
+// UpdateCampaignByID writes exactly the given columns of campaign, including zero values.
+// campaign.Version is the version the caller expects the stored campaign to have.
+func (r *campaignRepository) UpdateCampaignByID(ctx context.Context, id string, ownerID int32, actorID int32, campaign models.CampaignDB, fields []string) (models.CampaignDB, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Check if campaign exist in table
+		var retreivedCampaign models.CampaignDB
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&retreivedCampaign, "id=?", id).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		if err := checkOwner(retreivedCampaign, ownerID); err != nil {
+			return err
+		}
+		if err := checkVersion(retreivedCampaign, campaign.Version); err != nil {
+			return err
+		}
+
+		if err := currencyChangeError(retreivedCampaign, campaign, fields); err != nil {
+			return err
+		}
+
+		// check if current status cancelled, completed or under review
+		if retreivedCampaign.Status == models.StatusCancelled || retreivedCampaign.Status == models.StatusCompleted || retreivedCampaign.Status == models.StatusPendingReview {
+			return newError(ErrPrecondition, "CAMPAIGN_NOT_EDITABLE", "campaign status is %v", retreivedCampaign.Status)
+		}
+
+		if err := minDonationError(retreivedCampaign, campaign, fields); err != nil {
+			return err
+		}
+
+		// Status changes follow the same rules as the dedicated status RPCs
+		var columns []string
+		for _, field := range fields {
+			if field != "status" {
+				columns = append(columns, field)
+			} else if campaign.Status != retreivedCampaign.Status {
+				if err := transitionStatus(tx, &retreivedCampaign, models.CampaignTransitions, actorID, campaign.Status, "updated"); err != nil {
+					return err
+				}
+			}
+		}
+		if len(columns) == 0 {
+			return nil
+		}
+		campaign.Version = retreivedCampaign.Version + 1
+		columns = append(columns, "version")
+
+		// Update data, the row is locked so exactly one row has to change
+		result := tx.Model(&models.CampaignDB{}).Where("id=? AND user_id=?", id, ownerID).Select(columns).Updates(campaign)
+		if result.Error != nil {
+			return dbError(result.Error, "Error updating campaign")
+		}
+		if result.RowsAffected == 0 {
+			return newError(ErrConflict, "CONCURRENT_MODIFICATION", "Campaign changed while updating, please retry")
+		}
+		return nil
+	})
 	if err != nil {
-		return err
+		return models.CampaignDB{}, err
 	}
 
-	// Delete data and update status to "cancelled"
-	var campaign models.CampaignDB
-	if err := r.db.Model(&campaign).Where("id=?", id).Updates(map[string]interface{}{
-		"deleted_at": time.Now(),
-		"status":     "cancelled", // Ensure this matches your enum string
-	}).Error; err != nil {
-		return status.Error(codes.Internal, "Error deleting campaign")
+	return r.GetCampaignByID(ctx, id)
+}
+
+// minDonationError returns ErrInvalidArgument when writing fields of update would leave the minimum
+// donation of the stored campaign above its target, or nil
+// currencyChangeError checks a change of the campaign currency. Amounts must stay in the currency
+// the campaign was created with, drafts can still switch when both amounts are sent in the new
+// currency so that none of them is left in the units of the old one.
+func currencyChangeError(stored models.CampaignDB, update models.CampaignDB, fields []string) error {
+	if !slices.Contains(fields, "currency") || update.Currency == stored.Currency {
+		return nil
+	}
+	if !models.IsEditable(stored.Status) {
+		return newError(ErrInvalidArgument, "CURRENCY_MISMATCH", "campaign currency is %v, got %v", stored.Currency, update.Currency)
+	}
+	if !slices.Contains(fields, "target_amount") || !slices.Contains(fields, "min_donation") {
+		return newError(ErrInvalidArgument, "CURRENCY_CHANGE_INCOMPLETE", "changing the currency from %v to %v requires both target_amount and min_donation", stored.Currency, update.Currency).
+			with("currency", stored.Currency)
+	}
+	return nil
+}
+
+func minDonationError(stored models.CampaignDB, update models.CampaignDB, fields []string) error {
+	minDonation, target := stored.MinDonation, stored.TargetAmount
+	if slices.Contains(fields, "min_donation") {
+		minDonation = update.MinDonation
+	}
+	if slices.Contains(fields, "target_amount") {
+		target = update.TargetAmount
+	}
+	if minDonation > target {
+		return newError(ErrInvalidArgument, "MIN_DONATION_ABOVE_TARGET", "minimum donation of %v is above the target amount of %v", minDonation, target)
 	}
 	return nil
 }
 
-func (r *campaignRepository) UpdateCampaignByID(id string, userID int32, campaign models.CampaignDB) (interface{}, error) {
-	// Check if campaign exist in table
-	retreivedCampaign, err := r.GetCampaignByID(id)
+func (r *campaignRepository) GetCampaignsByUserID(ctx context.Context, userID int32, opts CampaignListOptions) (CampaignPage, error) {
+	// Count every matching campaign of the user regardless of the page
+	var total int64
+	if err := applyCampaignFilters(r.db.WithContext(ctx).Model(&models.CampaignDB{}), opts).Where("user_id=?", userID).Count(&total).Error; err != nil {
+		return CampaignPage{}, dbError(err, "Error counting campaigns")
+	}
+
+	// Get campaign by user id where deleted_at != nil
+	page, err := r.listCampaigns(r.db.WithContext(ctx).Where("user_id=?", userID), opts)
 	if err != nil {
-		return nil, err
+		return CampaignPage{}, err
 	}
-	// Cast to models.campaignDB
-	castedCampaign, ok := retreivedCampaign.(models.CampaignDB)
-	if !ok {
-		return nil, status.Error(codes.Internal, "Failed to cast campaign")
+	page.TotalCount = total
+	return page, nil
+}
+
+func (r *campaignRepository) ListCampaigns(ctx context.Context, opts CampaignListOptions) (CampaignPage, error) {
+	// Drafts are only visible to their owner
+	if len(opts.Statuses) == 0 {
+		opts.Statuses = models.PublicStatuses
+	}
+	base := r.db.WithContext(ctx)
+	if opts.IncludeDeleted {
+		base = base.Unscoped()
+	}
+	page, err := r.listCampaigns(base, opts)
+	if err != nil {
+		return CampaignPage{}, err
 	}
+	return page, nil
+}
 
-	// check if current status cancelled or completed
-	if castedCampaign.Status == "cancelled" || castedCampaign.Status == "completed" {
-		return nil, status.Errorf(codes.FailedPrecondition, "campaign status is %v", castedCampaign.Status)
+// listCampaigns returns one page of campaigns matching base and opts using keyset pagination
+func (r *campaignRepository) listCampaigns(base *gorm.DB, opts CampaignListOptions) (CampaignPage, error) {
+	cursor, err := listCursor(&opts)
+	if err != nil {
+		return CampaignPage{}, err
 	}
-	// Update data
-	if err := r.db.Model(campaign).Where("id=? AND user_id=?", id, userID).Updates(campaign).Error; err != nil {
-		return nil, status.Error(codes.Internal, "Error updating campaign")
+	sortExpr := campaignSortColumns[opts.SortBy]
+
+	query := applyCampaignFilters(base.Model(&models.CampaignDB{}), opts)
+
+	direction, comparator := "ASC", ">"
+	if opts.Descending {
+		direction, comparator = "DESC", "<"
 	}
 
-	updatedCampaign, err := r.GetCampaignByID(id)
+	// Continue after the last row of the previous page (keyset pagination)
+	if cursor != nil {
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", sortExpr, comparator), cursor.sortValue(), cursor.ID)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	pageSize := normalizePageSize(opts.PageSize)
+	var campaigns []models.CampaignDB
+	if err := query.Order(sortExpr + " " + direction).Order("id " + direction).Limit(pageSize + 1).Find(&campaigns).Error; err != nil {
+		return CampaignPage{}, dbError(err, "Error listing campaigns")
+	}
+
+	page := CampaignPage{Campaigns: campaigns}
+	if len(campaigns) > pageSize {
+		page.Campaigns = campaigns[:pageSize]
+		page.NextPageToken = encodePageToken(campaignCursor(page.Campaigns[pageSize-1], opts))
+	}
+	return page, nil
+}
+
+// applyCampaignDefaults fills in the column defaults of the campaigns table, for backends
+// that store campaigns without it
+func applyCampaignDefaults(campaign *models.CampaignDB, now time.Time) {
+	if campaign.Currency == "" {
+		campaign.Currency = "IDR"
+	}
+	if campaign.Status == "" {
+		campaign.Status = models.StatusDraft
+	}
+	if campaign.Version == 0 {
+		campaign.Version = 1
+	}
+	if campaign.CreatedAt.IsZero() {
+		campaign.CreatedAt = now
+	}
+	if campaign.UpdatedAt.IsZero() {
+		campaign.UpdatedAt = now
+	}
+}
+
+// listCursor applies the default sort order to opts, checks it and decodes the page token
+func listCursor(opts *CampaignListOptions) (*pageCursor, error) {
+	if opts.SortBy == "" {
+		opts.SortBy = "created_at"
+	}
+	if _, ok := campaignSortColumns[opts.SortBy]; !ok {
+		return nil, newError(ErrInvalidArgument, "UNSUPPORTED_SORT_FIELD", "Unsupported sort field %v", opts.SortBy)
+	}
+
+	cursor, err := decodePageToken(opts.PageToken)
 	if err != nil {
 		return nil, err
 	}
-	return updatedCampaign, nil
+	if cursor == nil {
+		return nil, nil
+	}
+	if cursor.SortBy != opts.SortBy || cursor.Descending != opts.Descending {
+		return nil, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Page token does not match the requested sort order")
+	}
+	if cursor.sortValue() == nil {
+		return nil, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Invalid page token")
+	}
+	return cursor, nil
 }
 
-func (r *campaignRepository) GetCampaignsByUserID(userID int32) (interface{}, error) {
-	var campaign []models.CampaignDB
-	// Get campaign by id where deleted_at != nil
-	if err := r.db.Where("user_id=?", userID).Find(&campaign).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "Campaign not found")
+// applyCampaignFilters adds the WHERE clauses described by opts to query
+func applyCampaignFilters(query *gorm.DB, opts CampaignListOptions) *gorm.DB {
+	if len(opts.Statuses) > 0 {
+		query = query.Where("status IN ?", opts.Statuses)
 	}
-	return campaign, nil
+	if len(opts.Categories) > 0 {
+		query = query.Where("category IN ?", opts.Categories)
+	}
+	if opts.DeadlineFrom != nil {
+		query = query.Where("deadline >= ?", *opts.DeadlineFrom)
+	}
+	if opts.DeadlineTo != nil {
+		query = query.Where("deadline <= ?", *opts.DeadlineTo)
+	}
+	if opts.Currency != "" {
+		query = query.Where("currency = ?", opts.Currency)
+	}
+	if opts.MinTarget != nil {
+		query = query.Where("target_amount >= ?", *opts.MinTarget)
+	}
+	if opts.MaxTarget != nil {
+		query = query.Where("target_amount <= ?", *opts.MaxTarget)
+	}
+	return query
+}
+
+// campaignCursor captures the sort key of the given campaign for the next page token
+func campaignCursor(campaign models.CampaignDB, opts CampaignListOptions) pageCursor {
+	cursor := pageCursor{SortBy: opts.SortBy, Descending: opts.Descending, ID: campaign.ID}
+	switch opts.SortBy {
+	case "deadline":
+		cursor.Time = &campaign.Deadline
+	case "collected_amount":
+		cursor.Integer = &campaign.CollectedAmount
+	case "percent_funded":
+		value := 0.0
+		if campaign.TargetAmount > 0 {
+			value = float64(campaign.CollectedAmount) / float64(campaign.TargetAmount)
+		}
+		cursor.Number = &value
+	default:
+		cursor.Time = &campaign.CreatedAt
+	}
+	return cursor
+}
+
+// CompleteDueCampaigns marks active campaigns as completed once their deadline has passed, or once they
+// reached their target when AutoCompleteOnTarget is set. It returns how many campaigns were completed,
+// or zero without doing anything while another replica holds the completion lock.
+func (r *campaignRepository) CompleteDueCampaigns(ctx context.Context, now time.Time) (int64, error) {
+	var completed int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only Postgres supports advisory locks, other backends are single instance
+		if r.db.Dialector.Name() == "postgres" {
+			var locked bool
+			if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", completionLockKey).Scan(&locked).Error; err != nil {
+				return dbError(err, "Error acquiring completion lock")
+			}
+			if !locked {
+				return nil
+			}
+		}
+
+		// Lock the due campaigns so donations and reversals landing meanwhile wait for the completion
+		var campaigns []models.CampaignDB
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status=? AND (deadline <= ? OR (auto_complete_on_target AND collected_amount >= target_amount))", models.StatusActive, now).
+			Order("id").Find(&campaigns).Error
+		if err != nil {
+			return dbError(err, "Error completing campaigns")
+		}
+		for _, val := range campaigns {
+			if err := transitionStatus(tx, &val, models.SystemTransitions, models.SystemUserID, models.StatusCompleted, completionReason(val, now)); err != nil {
+				return err
+			}
+			completed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return completed, nil
+}
+
+// completionReason tells why an active campaign is due to be completed at now, or returns "" when it is not
+func completionReason(campaign models.CampaignDB, now time.Time) string {
+	if !campaign.Deadline.After(now) {
+		return "deadline passed"
+	}
+	if campaign.AutoCompleteOnTarget && campaign.CollectedAmount >= campaign.TargetAmount {
+		return "target reached"
+	}
+	return ""
 }
@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+const (
+	highlightStart = "<mark>"
+	highlightStop  = "</mark>"
+	// placeholders marking matches until the text around them is escaped,
+	// control characters never appear in search terms
+	matchStart = "\x02"
+	matchStop  = "\x03"
+	// number of runes kept around a match in fallback description snippets
+	snippetRadius = 60
+)
+
+// searchTermPattern keeps letters and digits only, so user input can never inject tsquery operators
+var searchTermPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// CampaignSearchOptions holds the query and filters used by SearchCampaigns.
+type CampaignSearchOptions struct {
+	Query      string
+	Statuses   []string
+	Categories []string
+	PageSize   int
+	PageToken  string
+}
+
+// CampaignSearchResult is a campaign matching a search along with its relevance
+// and highlighted snippets of the matched fields.
+type CampaignSearchResult struct {
+	Campaign             models.CampaignDB
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// CampaignSearchPage is a single page of search results ordered by relevance.
+type CampaignSearchPage struct {
+	Results       []CampaignSearchResult
+	NextPageToken string
+}
+
+// campaignSearchRow is the row shape returned by the Postgres search query
+type campaignSearchRow struct {
+	models.CampaignDB    `gorm:"embedded"`
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+func (r *campaignRepository) SearchCampaigns(ctx context.Context, opts CampaignSearchOptions) (CampaignSearchPage, error) {
+	terms, cursor, err := prepareSearch(&opts)
+	if err != nil {
+		return CampaignSearchPage{}, err
+	}
+
+	// Only Postgres has the search_vector column, other backends scan with LIKE
+	if r.db.Dialector.Name() != "postgres" {
+		return r.searchCampaignsFallback(ctx, terms, opts, cursor)
+	}
+
+	// Every term must match as a word prefix, the same as rankCampaign
+	tsQuery := strings.Join(terms, ":* & ") + ":*"
+	rankExpr := "CAST(ts_rank(search_vector, to_tsquery('simple', ?)) AS DOUBLE PRECISION)"
+
+	query := r.db.WithContext(ctx).Model(&models.CampaignDB{}).
+		Select("campaigns.campaigns.*, "+rankExpr+" AS rank, "+
+			"ts_headline('simple', title, to_tsquery('simple', ?), 'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true') AS title_highlight, "+
+			"ts_headline('simple', coalesce(description, ''), to_tsquery('simple', ?), 'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=20, MinWords=5') AS description_highlight",
+			tsQuery, tsQuery, tsQuery).
+		Where("search_vector @@ to_tsquery('simple', ?)", tsQuery)
+	query = applyCampaignFilters(query, CampaignListOptions{Statuses: opts.Statuses, Categories: opts.Categories})
+
+	// Continue after the last result of the previous page
+	if cursor != nil {
+		query = query.Where("("+rankExpr+", id) < (?, ?)", tsQuery, *cursor.Number, cursor.ID)
+	}
+
+	pageSize := normalizePageSize(opts.PageSize)
+	var rows []campaignSearchRow
+	if err := query.Order("rank DESC").Order("id DESC").Limit(pageSize + 1).Scan(&rows).Error; err != nil {
+		return CampaignSearchPage{}, dbError(err, "Error searching campaigns")
+	}
+
+	var results []CampaignSearchResult
+	for _, row := range rows {
+		results = append(results, CampaignSearchResult{
+			Campaign:             row.CampaignDB,
+			Rank:                 row.Rank,
+			TitleHighlight:       markMatches(row.TitleHighlight),
+			DescriptionHighlight: markMatches(row.DescriptionHighlight),
+		})
+	}
+	return searchPage(results, pageSize), nil
+}
+
+// searchCampaignsFallback matches terms with LIKE and ranks and highlights in Go.
+// It is meant for backends without full-text search support.
+func (r *campaignRepository) searchCampaignsFallback(ctx context.Context, terms []string, opts CampaignSearchOptions, cursor *pageCursor) (CampaignSearchPage, error) {
+	query := applyCampaignFilters(r.db.WithContext(ctx).Model(&models.CampaignDB{}), CampaignListOptions{Statuses: opts.Statuses, Categories: opts.Categories})
+	for _, term := range terms {
+		pattern := "%" + term + "%"
+		query = query.Where("(LOWER(title) LIKE ? OR LOWER(description) LIKE ?)", pattern, pattern)
+	}
+
+	var campaigns []models.CampaignDB
+	if err := query.Find(&campaigns).Error; err != nil {
+		return CampaignSearchPage{}, dbError(err, "Error searching campaigns")
+	}
+	return rankSearchResults(campaigns, terms, cursor, normalizePageSize(opts.PageSize)), nil
+}
+
+// prepareSearch splits the query into terms, applies the default statuses to opts and decodes its page token
+func prepareSearch(opts *CampaignSearchOptions) ([]string, *pageCursor, error) {
+	terms := searchTerms(opts.Query)
+	if len(terms) == 0 {
+		return nil, nil, newError(ErrInvalidArgument, "EMPTY_SEARCH_QUERY", "Search query must contain at least one word")
+	}
+
+	// Drafts are only visible to their owner
+	if len(opts.Statuses) == 0 {
+		opts.Statuses = models.PublicStatuses
+	}
+
+	cursor, err := decodePageToken(opts.PageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cursor != nil && (cursor.SortBy != "rank" || cursor.Number == nil) {
+		return nil, nil, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Invalid page token")
+	}
+	return terms, cursor, nil
+}
+
+// rankSearchResults ranks and highlights campaigns in Go and returns the page after cursor.
+// Campaigns that do not match every term are dropped.
+func rankSearchResults(campaigns []models.CampaignDB, terms []string, cursor *pageCursor, pageSize int) CampaignSearchPage {
+	var results []CampaignSearchResult
+	for _, val := range campaigns {
+		rank := rankCampaign(val, terms)
+		if rank == 0 {
+			continue
+		}
+		results = append(results, CampaignSearchResult{
+			Campaign:             val,
+			Rank:                 rank,
+			TitleHighlight:       markMatches(highlightTerms(val.Title, terms)),
+			DescriptionHighlight: markMatches(highlightTerms(snippet(val.Description, terms), terms)),
+		})
+	}
+
+	// Same ordering as the Postgres query so page tokens behave identically
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return results[i].Campaign.ID > results[j].Campaign.ID
+	})
+	if cursor != nil {
+		start := len(results)
+		for i, val := range results {
+			if val.Rank < *cursor.Number || (val.Rank == *cursor.Number && val.Campaign.ID < cursor.ID) {
+				start = i
+				break
+			}
+		}
+		results = results[start:]
+	}
+
+	if len(results) > pageSize+1 {
+		results = results[:pageSize+1]
+	}
+	return searchPage(results, pageSize)
+}
+
+// searchPage trims results fetched with one extra row into a page and its next token
+func searchPage(results []CampaignSearchResult, pageSize int) CampaignSearchPage {
+	page := CampaignSearchPage{Results: results}
+	if len(results) > pageSize {
+		page.Results = results[:pageSize]
+		last := page.Results[pageSize-1]
+		rank := last.Rank
+		page.NextPageToken = encodePageToken(pageCursor{SortBy: "rank", Number: &rank, ID: last.Campaign.ID})
+	}
+	return page
+}
+
+// searchTerms lowercases the query and splits it into plain words
+func searchTerms(query string) []string {
+	return searchTermPattern.FindAllString(strings.ToLower(query), -1)
+}
+
+// rankCampaign scores a campaign by how many words start with a search term,
+// weighting title matches above description matches. Zero means no match.
+func rankCampaign(campaign models.CampaignDB, terms []string) float64 {
+	titleWords := searchTerms(campaign.Title)
+	descriptionWords := searchTerms(campaign.Description)
+
+	var rank float64
+	for _, term := range terms {
+		matched := false
+		for _, word := range titleWords {
+			if strings.HasPrefix(word, term) {
+				rank += 1
+				matched = true
+			}
+		}
+		for _, word := range descriptionWords {
+			if strings.HasPrefix(word, term) {
+				rank += 0.4
+				matched = true
+			}
+		}
+		// every term has to match, like the & in the tsquery
+		if !matched {
+			return 0
+		}
+	}
+	return rank / float64(len(titleWords)+len(descriptionWords))
+}
+
+// highlightTerms wraps every word starting with a search term in match placeholders
+func highlightTerms(text string, terms []string) string {
+	return searchTermPattern.ReplaceAllStringFunc(text, func(word string) string {
+		lower := strings.ToLower(word)
+		for _, term := range terms {
+			if strings.HasPrefix(lower, term) {
+				return matchStart + word + matchStop
+			}
+		}
+		return word
+	})
+}
+
+// markMatches escapes the campaign text so it is safe to render as HTML, then turns the
+// match placeholders into highlight markers
+func markMatches(text string) string {
+	return strings.NewReplacer(matchStart, highlightStart, matchStop, highlightStop).Replace(html.EscapeString(text))
+}
+
+// snippet cuts text down to the area around the first matching term
+func snippet(text string, terms []string) string {
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(runes) <= snippetRadius*2 || len(lower) != len(runes) {
+		return text
+	}
+
+	position := -1
+	for _, term := range terms {
+		if index := strings.Index(string(lower), term); index >= 0 {
+			position = len([]rune(string(lower)[:index]))
+			break
+		}
+	}
+	if position < 0 {
+		position = 0
+	}
+
+	start, end := position-snippetRadius, position+snippetRadius
+	if start < 0 {
+		start = 0
+	}
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	result := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		result = "..." + result
+	}
+	if end < len(runes) {
+		result = result + "..."
+	}
+	return result
+}
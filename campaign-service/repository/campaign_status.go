@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+func (r *campaignRepository) ChangeCampaignStatus(ctx context.Context, id string, ownerID int32, actorID int32, version int64, to string, reason string) (models.CampaignDB, error) {
+	var campaign models.CampaignDB
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the campaign so concurrent status changes are checked one after another
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&campaign, "id=?", id).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		if err := checkOwner(campaign, ownerID); err != nil {
+			return err
+		}
+		if err := checkVersion(campaign, version); err != nil {
+			return err
+		}
+		return transitionStatus(tx, &campaign, models.CampaignTransitions, actorID, to, reason)
+	})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	return campaign, nil
+}
+
+// ReviewCampaign lets a moderator approve (to active) or reject a campaign waiting for review.
+// Unlike ChangeCampaignStatus it does not require the caller to own the campaign.
+func (r *campaignRepository) ReviewCampaign(ctx context.Context, id string, moderatorID int32, version int64, to string, reason string) (models.CampaignDB, error) {
+	var campaign models.CampaignDB
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&campaign, "id=?", id).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		if err := checkVersion(campaign, version); err != nil {
+			return err
+		}
+		if campaign.Status != models.StatusPendingReview {
+			return newError(ErrInvalidTransition, "CAMPAIGN_NOT_PENDING_REVIEW", "campaign status is %v, only %v campaigns can be reviewed", campaign.Status, models.StatusPendingReview)
+		}
+		if err := transitionStatus(tx, &campaign, models.ModeratorTransitions, moderatorID, to, reason); err != nil {
+			return err
+		}
+
+		// Keep the latest rejection reason on the campaign so the owner can act on it
+		rejectionReason := ""
+		if to == models.StatusRejected {
+			rejectionReason = reason
+		}
+		if err := tx.Model(&campaign).Update("rejection_reason", rejectionReason).Error; err != nil {
+			return dbError(err, "Error updating campaign")
+		}
+		return nil
+	})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	return campaign, nil
+}
+
+// ForceCancelCampaign lets an admin cancel a campaign from any status except cancelled, bypassing
+// models.CampaignTransitions. The change is still written to the status history.
+func (r *campaignRepository) ForceCancelCampaign(ctx context.Context, id string, adminID int32, reason string) (models.CampaignDB, error) {
+	var campaign models.CampaignDB
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&campaign, "id=?", id).Error; err != nil {
+			return notFoundError(err, "CAMPAIGN_NOT_FOUND", "Campaign not found")
+		}
+		if campaign.Status == models.StatusCancelled {
+			return invalidTransitionError(campaign.ID, models.CampaignTransitions, campaign.Status, models.StatusCancelled)
+		}
+
+		from := campaign.Status
+		if err := updateStatus(tx, &campaign, models.StatusCancelled); err != nil {
+			return err
+		}
+		if err := tx.Create(&models.CampaignStatusChangeDB{
+			CampaignID: campaign.ID,
+			UserID:     adminID,
+			FromStatus: from,
+			ToStatus:   models.StatusCancelled,
+			Reason:     reason,
+		}).Error; err != nil {
+			return dbError(err, "Error recording status change")
+		}
+		return nil
+	})
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	return campaign, nil
+}
+
+// GetStatusHistory returns every status change of a campaign, oldest first. Deleted campaigns keep their history.
+func (r *campaignRepository) GetStatusHistory(ctx context.Context, id string) ([]models.CampaignStatusChangeDB, error) {
+	var changes []models.CampaignStatusChangeDB
+	if err := r.db.WithContext(ctx).Where("campaign_id=?", id).Order("created_at").Order("id").Find(&changes).Error; err != nil {
+		return nil, dbError(err, "Error reading status history")
+	}
+	return changes, nil
+}
+
+// transitionStatus is the one place where status changes are checked against transitions and
+// written to the status history, userID is models.SystemUserID for changes the service makes
+// on its own. campaign must be locked by tx.
+func transitionStatus(tx *gorm.DB, campaign *models.CampaignDB, transitions models.StatusTransitions, userID int32, to string, reason string) error {
+	from := campaign.Status
+	if err := checkTransition(campaign, transitions, to); err != nil {
+		return err
+	}
+
+	if err := updateStatus(tx, campaign, to); err != nil {
+		return err
+	}
+	if err := tx.Create(&models.CampaignStatusChangeDB{
+		CampaignID: campaign.ID,
+		UserID:     userID,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+	}).Error; err != nil {
+		return dbError(err, "Error recording status change")
+	}
+	return nil
+}
+
+// checkTransition checks a status change against transitions, models.CampaignTransitions for owners,
+// models.ModeratorTransitions for reviews and models.SystemTransitions for the service itself. Every repository implementation calls it
+// before changing the status of a campaign.
+func checkTransition(campaign *models.CampaignDB, transitions models.StatusTransitions, to string) error {
+	from := campaign.Status
+	if !transitions.Allows(from, to) {
+		return invalidTransitionError(campaign.ID, transitions, from, to)
+	}
+	// A campaign has to be complete before it is reviewed and before it goes live
+	if to == models.StatusPendingReview || (from == models.StatusPendingReview && to == models.StatusActive) {
+		if err := publishableError(campaign); err != nil {
+			return err
+		}
+	}
+	// A campaign cannot take donations again after its deadline
+	if to == models.StatusActive && !campaign.Deadline.After(time.Now()) {
+		return newError(ErrPrecondition, "CAMPAIGN_DEADLINE_PASSED", "campaign deadline has passed")
+	}
+	return nil
+}
+
+// updateStatus writes the new status of a locked campaign and bumps its version
+func updateStatus(tx *gorm.DB, campaign *models.CampaignDB, to string) error {
+	err := tx.Model(campaign).Clauses(clause.Returning{}).Updates(map[string]interface{}{
+		"status":  to,
+		"version": gorm.Expr("version + 1"),
+	}).Error
+	if err != nil {
+		return dbError(err, "Error updating campaign status")
+	}
+	return nil
+}
+
+// checkOwner returns ErrPermissionDenied unless ownerID owns the campaign. Every mutation on behalf of
+// the owner checks it the same way, so non-owners learn the campaign exists but cannot change it.
+func checkOwner(campaign models.CampaignDB, ownerID int32) error {
+	if campaign.UserID != ownerID {
+		return newError(ErrPermissionDenied, "NOT_CAMPAIGN_OWNER", "Campaign belongs to another user").with("campaign_id", campaign.ID)
+	}
+	return nil
+}
+
+// checkVersion returns Aborted when the campaign was changed since the caller read the given version
+func checkVersion(campaign models.CampaignDB, version int64) error {
+	if campaign.Version != version {
+		return newError(ErrConflict, "ETAG_MISMATCH", "campaign %v was modified by someone else, reload it and retry", campaign.ID).with("campaign_id", campaign.ID)
+	}
+	return nil
+}
+
+// invalidTransitionError returns ErrInvalidTransition listing the statuses transitions allow from the current one
+func invalidTransitionError(id string, transitions models.StatusTransitions, from string, to string) error {
+	allowed := transitions[from]
+	description := "no status change is allowed"
+	if len(allowed) > 0 {
+		description = "allowed: " + strings.Join(allowed, ", ")
+	}
+
+	err := newError(ErrInvalidTransition, "INVALID_STATUS_TRANSITION", "campaign cannot change status from %v to %v, %v", from, to, description).
+		with("campaign_id", id).
+		with("from", from).
+		with("to", to)
+	err.Violations = []Violation{{Type: "STATUS_TRANSITION", Subject: "campaigns/" + id, Description: description}}
+	return err
+}
+
+// publishableError returns ErrPrecondition listing every field a draft still misses, or nil
+func publishableError(campaign *models.CampaignDB) error {
+	var violations []Violation
+	missing := func(field string, description string) {
+		violations = append(violations, Violation{Type: "INCOMPLETE_CAMPAIGN", Subject: field, Description: description})
+	}
+	if strings.TrimSpace(campaign.Title) == "" {
+		missing("title", "title is required")
+	}
+	if strings.TrimSpace(campaign.Description) == "" {
+		missing("description", "description is required")
+	}
+	if campaign.TargetAmount <= 0 {
+		missing("target_amount", "target amount must be greater than zero")
+	}
+	if !campaign.Deadline.After(time.Now()) {
+		missing("deadline", "deadline must be in the future")
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	err := newError(ErrPrecondition, "CAMPAIGN_INCOMPLETE", "campaign is not ready to be published").with("campaign_id", campaign.ID)
+	err.Violations = violations
+	return err
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// memoryCampaignUpdateRepository keeps campaign updates in memory, for local development and tests.
+type memoryCampaignUpdateRepository struct {
+	mu      sync.RWMutex
+	updates map[string]models.CampaignUpdateDB
+}
+
+// Constructor NewMemoryCampaignUpdateRepository creates and returns an empty in-memory CampaignUpdateRepository.
+func NewMemoryCampaignUpdateRepository() CampaignUpdateRepository {
+	return &memoryCampaignUpdateRepository{updates: map[string]models.CampaignUpdateDB{}}
+}
+
+func (r *memoryCampaignUpdateRepository) CreateCampaignUpdate(ctx context.Context, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignUpdateDB{}, dbError(err, "Failed to create a campaign update")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.updates[update.ID]; ok {
+		return models.CampaignUpdateDB{}, newError(ErrAlreadyExists, "ALREADY_EXISTS", "Failed to create a campaign update")
+	}
+	now := time.Now()
+	if update.CreatedAt.IsZero() {
+		update.CreatedAt = now
+	}
+	if update.UpdatedAt.IsZero() {
+		update.UpdatedAt = now
+	}
+	r.updates[update.ID] = update
+	return update, nil
+}
+
+func (r *memoryCampaignUpdateRepository) GetCampaignUpdateByID(ctx context.Context, id string) (models.CampaignUpdateDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignUpdateDB{}, dbError(err, "Error reading campaign update")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	update, ok := r.updates[id]
+	if !ok || update.DeletedAt.Valid {
+		return models.CampaignUpdateDB{}, newError(ErrNotFound, "CAMPAIGN_UPDATE_NOT_FOUND", "Campaign update not found")
+	}
+	return update, nil
+}
+
+func (r *memoryCampaignUpdateRepository) ListCampaignUpdates(ctx context.Context, campaignID string, pageSize int, pageToken string) (CampaignUpdatePage, error) {
+	if err := ctx.Err(); err != nil {
+		return CampaignUpdatePage{}, dbError(err, "Error listing campaign updates")
+	}
+	cursor, err := decodePageToken(pageToken)
+	if err != nil {
+		return CampaignUpdatePage{}, err
+	}
+	if cursor != nil && (cursor.SortBy != "created_at" || cursor.Time == nil) {
+		return CampaignUpdatePage{}, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Invalid page token")
+	}
+
+	r.mu.RLock()
+	var updates []models.CampaignUpdateDB
+	for _, val := range r.updates {
+		if val.CampaignID == campaignID && !val.DeletedAt.Valid {
+			updates = append(updates, val)
+		}
+	}
+	r.mu.RUnlock()
+
+	// Newest first, continuing after the last update of the previous page
+	position := func(update models.CampaignUpdateDB) pageCursor {
+		return pageCursor{Time: &update.CreatedAt, ID: update.ID}
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		return compareCursors(position(updates[i]), position(updates[j])) > 0
+	})
+	if cursor != nil {
+		start := len(updates)
+		for i, val := range updates {
+			if compareCursors(position(val), *cursor) < 0 {
+				start = i
+				break
+			}
+		}
+		updates = updates[start:]
+	}
+
+	pageSize = normalizePageSize(pageSize)
+	page := CampaignUpdatePage{Updates: updates}
+	if len(updates) > pageSize {
+		page.Updates = updates[:pageSize]
+		last := page.Updates[pageSize-1]
+		page.NextPageToken = encodePageToken(pageCursor{SortBy: "created_at", Descending: true, Time: &last.CreatedAt, ID: last.ID})
+	}
+	return page, nil
+}
+
+func (r *memoryCampaignUpdateRepository) EditCampaignUpdate(ctx context.Context, id string, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CampaignUpdateDB{}, dbError(err, "Error updating campaign update")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.updates[id]
+	if !ok || stored.DeletedAt.Valid {
+		return models.CampaignUpdateDB{}, newError(ErrNotFound, "CAMPAIGN_UPDATE_NOT_FOUND", "Campaign update not found")
+	}
+	// Title and content are always sent together, so both are written even when empty
+	stored.Title = update.Title
+	stored.Content = update.Content
+	stored.UpdatedAt = time.Now()
+	r.updates[id] = stored
+	return stored, nil
+}
+
+func (r *memoryCampaignUpdateRepository) DeleteCampaignUpdate(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return dbError(err, "Error deleting campaign update")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.updates[id]
+	if !ok || stored.DeletedAt.Valid {
+		return newError(ErrNotFound, "CAMPAIGN_UPDATE_NOT_FOUND", "Campaign update not found")
+	}
+	// Soft delete, the update keeps its content for auditing
+	stored.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	r.updates[id] = stored
+	return nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// mongoCampaignUpdateRepository is the MongoDB implementation of CampaignUpdateRepository.
+type mongoCampaignUpdateRepository struct {
+	updates *mongo.Collection
+}
+
+// Constructor NewMongoCampaignUpdateRepository creates and returns a new instance of mongoCampaignUpdateRepository,
+// injecting the Mongo database.
+func NewMongoCampaignUpdateRepository(db *mongo.Database) CampaignUpdateRepository {
+	return &mongoCampaignUpdateRepository{updates: db.Collection(campaignUpdatesCollection)}
+}
+
+func (r *mongoCampaignUpdateRepository) CreateCampaignUpdate(ctx context.Context, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error) {
+	now := time.Now()
+	if update.CreatedAt.IsZero() {
+		update.CreatedAt = now
+	}
+	if update.UpdatedAt.IsZero() {
+		update.UpdatedAt = now
+	}
+
+	document := newCampaignUpdateDocument(update)
+	if _, err := r.updates.InsertOne(ctx, document); err != nil {
+		return models.CampaignUpdateDB{}, mongoError(err, "Failed to create a campaign update")
+	}
+	return document.model(), nil
+}
+
+func (r *mongoCampaignUpdateRepository) GetCampaignUpdateByID(ctx context.Context, id string) (models.CampaignUpdateDB, error) {
+	var document campaignUpdateDocument
+	if err := r.updates.FindOne(ctx, bson.M{"_id": id, "deleted_at": nil}).Decode(&document); err != nil {
+		return models.CampaignUpdateDB{}, campaignUpdateError(err, "Error reading campaign update")
+	}
+	return document.model(), nil
+}
+
+func (r *mongoCampaignUpdateRepository) ListCampaignUpdates(ctx context.Context, campaignID string, pageSize int, pageToken string) (CampaignUpdatePage, error) {
+	cursor, err := decodePageToken(pageToken)
+	if err != nil {
+		return CampaignUpdatePage{}, err
+	}
+	if cursor != nil && (cursor.SortBy != "created_at" || cursor.Time == nil) {
+		return CampaignUpdatePage{}, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Invalid page token")
+	}
+
+	filter := bson.M{"campaign_id": campaignID, "deleted_at": nil}
+	if cursor != nil {
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$lt": *cursor.Time}},
+			bson.M{"created_at": *cursor.Time, "_id": bson.M{"$lt": cursor.ID}},
+		}
+	}
+
+	// Fetch one extra document to know whether there is a next page
+	pageSize = normalizePageSize(pageSize)
+	found, err := r.updates.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(pageSize+1)))
+	if err != nil {
+		return CampaignUpdatePage{}, mongoError(err, "Error listing campaign updates")
+	}
+	var documents []campaignUpdateDocument
+	if err := found.All(ctx, &documents); err != nil {
+		return CampaignUpdatePage{}, mongoError(err, "Error listing campaign updates")
+	}
+
+	var updates []models.CampaignUpdateDB
+	for _, val := range documents {
+		updates = append(updates, val.model())
+	}
+	page := CampaignUpdatePage{Updates: updates}
+	if len(updates) > pageSize {
+		page.Updates = updates[:pageSize]
+		last := page.Updates[pageSize-1]
+		page.NextPageToken = encodePageToken(pageCursor{SortBy: "created_at", Descending: true, Time: &last.CreatedAt, ID: last.ID})
+	}
+	return page, nil
+}
+
+func (r *mongoCampaignUpdateRepository) EditCampaignUpdate(ctx context.Context, id string, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error) {
+	// Title and content are always sent together, so both are written even when empty
+	var document campaignUpdateDocument
+	err := r.updates.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "deleted_at": nil},
+		bson.M{"$set": bson.M{"title": update.Title, "content": update.Content, "updated_at": bsonTime(time.Now())}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		return models.CampaignUpdateDB{}, campaignUpdateError(err, "Error updating campaign update")
+	}
+	return document.model(), nil
+}
+
+func (r *mongoCampaignUpdateRepository) DeleteCampaignUpdate(ctx context.Context, id string) error {
+	// Soft delete, the document keeps its content for auditing
+	result, err := r.updates.UpdateOne(ctx, bson.M{"_id": id, "deleted_at": nil}, bson.M{"$set": bson.M{"deleted_at": bsonTime(time.Now())}})
+	if err != nil {
+		return mongoError(err, "Error deleting campaign update")
+	}
+	if result.MatchedCount == 0 {
+		return newError(ErrNotFound, "CAMPAIGN_UPDATE_NOT_FOUND", "Campaign update not found")
+	}
+	return nil
+}
+
+// campaignUpdateError maps a missing document to CAMPAIGN_UPDATE_NOT_FOUND, anything else is a storage failure
+func campaignUpdateError(err error, message string) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &Error{Kind: ErrNotFound, Reason: "CAMPAIGN_UPDATE_NOT_FOUND", Message: "Campaign update not found", Err: err}
+	}
+	return mongoError(err, message)
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// CampaignUpdateRepository defines methods for the news posts organizers publish on their campaigns.
+type CampaignUpdateRepository interface {
+	CreateCampaignUpdate(ctx context.Context, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error)
+	GetCampaignUpdateByID(ctx context.Context, id string) (models.CampaignUpdateDB, error)
+	ListCampaignUpdates(ctx context.Context, campaignID string, pageSize int, pageToken string) (CampaignUpdatePage, error)
+	EditCampaignUpdate(ctx context.Context, id string, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error)
+	DeleteCampaignUpdate(ctx context.Context, id string) error
+}
+
+// CampaignUpdatePage is a single page of campaign updates, newest first.
+type CampaignUpdatePage struct {
+	Updates       []models.CampaignUpdateDB
+	NextPageToken string
+}
+
+// campaignUpdateRepository is the gorm implementation of CampaignUpdateRepository.
+type campaignUpdateRepository struct {
+	db *gorm.DB
+}
+
+// Constructor NewCampaignUpdateRepository creates and returns a new instance of campaignUpdateRepository,
+// injecting the gorm database connection.
+func NewCampaignUpdateRepository(db *gorm.DB) CampaignUpdateRepository {
+	return &campaignUpdateRepository{db: db}
+}
+
+func (r *campaignUpdateRepository) CreateCampaignUpdate(ctx context.Context, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error) {
+	// Insert update to campaign_updates table
+	result := r.db.WithContext(ctx).Create(&update)
+	if result.Error != nil {
+		return models.CampaignUpdateDB{}, dbError(result.Error, "Failed to create a campaign update")
+	}
+	if result.RowsAffected == 0 {
+		return models.CampaignUpdateDB{}, newError(ErrInternal, "INTERNAL", "Failed to create a campaign update")
+	}
+	return update, nil
+}
+
+func (r *campaignUpdateRepository) GetCampaignUpdateByID(ctx context.Context, id string) (models.CampaignUpdateDB, error) {
+	var update models.CampaignUpdateDB
+	// Get update by id where deleted_at != nil
+	if err := r.db.WithContext(ctx).First(&update, "id=?", id).Error; err != nil {
+		return models.CampaignUpdateDB{}, notFoundError(err, "CAMPAIGN_UPDATE_NOT_FOUND", "Campaign update not found")
+	}
+	return update, nil
+}
+
+func (r *campaignUpdateRepository) ListCampaignUpdates(ctx context.Context, campaignID string, pageSize int, pageToken string) (CampaignUpdatePage, error) {
+	cursor, err := decodePageToken(pageToken)
+	if err != nil {
+		return CampaignUpdatePage{}, err
+	}
+	if cursor != nil && (cursor.SortBy != "created_at" || cursor.Time == nil) {
+		return CampaignUpdatePage{}, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Invalid page token")
+	}
+
+	query := r.db.WithContext(ctx).Where("campaign_id=?", campaignID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", *cursor.Time, cursor.ID)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	pageSize = normalizePageSize(pageSize)
+	var updates []models.CampaignUpdateDB
+	if err := query.Order("created_at DESC").Order("id DESC").Limit(pageSize + 1).Find(&updates).Error; err != nil {
+		return CampaignUpdatePage{}, dbError(err, "Error listing campaign updates")
+	}
+
+	page := CampaignUpdatePage{Updates: updates}
+	if len(updates) > pageSize {
+		page.Updates = updates[:pageSize]
+		last := page.Updates[pageSize-1]
+		page.NextPageToken = encodePageToken(pageCursor{SortBy: "created_at", Descending: true, Time: &last.CreatedAt, ID: last.ID})
+	}
+	return page, nil
+}
+
+func (r *campaignUpdateRepository) EditCampaignUpdate(ctx context.Context, id string, update models.CampaignUpdateDB) (models.CampaignUpdateDB, error) {
+	// Title and content are always sent together, so both are written even when empty
+	result := r.db.WithContext(ctx).Model(&models.CampaignUpdateDB{}).Where("id=?", id).Updates(map[string]interface{}{
+		"title":   update.Title,
+		"content": update.Content,
+	})
+	if result.Error != nil {
+		return models.CampaignUpdateDB{}, dbError(result.Error, "Error updating campaign update")
+	}
+	if result.RowsAffected == 0 {
+		return models.CampaignUpdateDB{}, newError(ErrNotFound, "CAMPAIGN_UPDATE_NOT_FOUND", "Campaign update not found")
+	}
+	return r.GetCampaignUpdateByID(ctx, id)
+}
+
+func (r *campaignUpdateRepository) DeleteCampaignUpdate(ctx context.Context, id string) error {
+	// Soft delete, the row keeps its content for auditing
+	result := r.db.WithContext(ctx).Where("id=?", id).Delete(&models.CampaignUpdateDB{})
+	if result.Error != nil {
+		return dbError(result.Error, "Error deleting campaign update")
+	}
+	if result.RowsAffected == 0 {
+		return newError(ErrNotFound, "CAMPAIGN_UPDATE_NOT_FOUND", "Campaign update not found")
+	}
+	return nil
+}
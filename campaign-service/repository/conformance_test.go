@@ -0,0 +1,127 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository/repotest"
+)
+
+func TestMemoryCampaignRepository(t *testing.T) {
+	repotest.RunCampaignRepository(t, func(t *testing.T) repository.CampaignRepository {
+		return repository.NewMemoryCampaignRepository()
+	})
+	t.Run("Updates", func(t *testing.T) {
+		repotest.RunCampaignUpdateRepository(t, func(t *testing.T) (repository.CampaignUpdateRepository, repository.CampaignRepository) {
+			return repository.NewMemoryCampaignUpdateRepository(), repository.NewMemoryCampaignRepository()
+		})
+	})
+	t.Run("IdempotencyKeys", func(t *testing.T) {
+		repotest.RunIdempotencyRepository(t, func(t *testing.T) (repository.IdempotencyRepository, func(string, string, time.Duration)) {
+			keys := repository.NewMemoryIdempotencyRepository()
+			return keys, func(key string, operation string, age time.Duration) {
+				repository.AgeMemoryIdempotencyKey(keys, key, operation, age)
+			}
+		})
+	})
+}
+
+// TestPostgresCampaignRepository runs against the database at CAMPAIGN_TEST_POSTGRES_DSN, which has
+// to be migrated with migrations/*.sql. Its tables are emptied before every case.
+// make test-backends starts such a database in docker and runs this together with the Mongo suite.
+func TestPostgresCampaignRepository(t *testing.T) {
+	dsn := os.Getenv("CAMPAIGN_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("CAMPAIGN_TEST_POSTGRES_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect database %v", err)
+	}
+
+	truncate := func(t *testing.T) {
+		err := db.Exec("TRUNCATE campaigns.campaigns, campaigns.campaign_contributions, campaigns.campaign_reversals, campaigns.campaign_status_changes, campaigns.campaign_updates, campaigns.idempotency_keys CASCADE").Error
+		if err != nil {
+			t.Fatalf("Failed to empty campaign tables %v", err)
+		}
+	}
+
+	repotest.RunCampaignRepository(t, func(t *testing.T) repository.CampaignRepository {
+		truncate(t)
+		return repository.NewCampaignRepository(db)
+	})
+	t.Run("Updates", func(t *testing.T) {
+		repotest.RunCampaignUpdateRepository(t, func(t *testing.T) (repository.CampaignUpdateRepository, repository.CampaignRepository) {
+			truncate(t)
+			return repository.NewCampaignUpdateRepository(db), repository.NewCampaignRepository(db)
+		})
+	})
+	t.Run("IdempotencyKeys", func(t *testing.T) {
+		repotest.RunIdempotencyRepository(t, func(t *testing.T) (repository.IdempotencyRepository, func(string, string, time.Duration)) {
+			truncate(t)
+			return repository.NewIdempotencyRepository(db), func(key string, operation string, age time.Duration) {
+				err := db.Exec("UPDATE campaigns.idempotency_keys SET updated_at = updated_at - make_interval(secs => ?) WHERE key = ? AND operation = ?", age.Seconds(), key, operation).Error
+				if err != nil {
+					t.Fatalf("Failed to age idempotency key %v", err)
+				}
+			}
+		})
+	})
+}
+
+// TestMongoCampaignRepository runs against the deployment at CAMPAIGN_TEST_MONGO_URI, every case in
+// a database of its own that is dropped afterwards. make test-backends provides one in docker.
+func TestMongoCampaignRepository(t *testing.T) {
+	uri := os.Getenv("CAMPAIGN_TEST_MONGO_URI")
+	if uri == "" {
+		t.Skip("CAMPAIGN_TEST_MONGO_URI is not set")
+	}
+	ctx := context.Background()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("Failed to connect to MongoDB %v", err)
+	}
+	t.Cleanup(func() { client.Disconnect(ctx) })
+
+	newDatabase := func(t *testing.T) *mongo.Database {
+		db := client.Database("campaign_test_" + uuid.NewString()[:8])
+		t.Cleanup(func() { db.Drop(ctx) })
+		if err := repository.EnsureMongoIndexes(ctx, db); err != nil {
+			t.Fatalf("Failed to create indexes %v", err)
+		}
+		return db
+	}
+
+	repotest.RunCampaignRepository(t, func(t *testing.T) repository.CampaignRepository {
+		return repository.NewMongoCampaignRepository(newDatabase(t))
+	})
+	t.Run("Updates", func(t *testing.T) {
+		repotest.RunCampaignUpdateRepository(t, func(t *testing.T) (repository.CampaignUpdateRepository, repository.CampaignRepository) {
+			db := newDatabase(t)
+			return repository.NewMongoCampaignUpdateRepository(db), repository.NewMongoCampaignRepository(db)
+		})
+	})
+	t.Run("IdempotencyKeys", func(t *testing.T) {
+		repotest.RunIdempotencyRepository(t, func(t *testing.T) (repository.IdempotencyRepository, func(string, string, time.Duration)) {
+			db := newDatabase(t)
+			return repository.NewMongoIdempotencyRepository(db), func(key string, operation string, age time.Duration) {
+				_, err := db.Collection("idempotency_keys").UpdateOne(ctx,
+					bson.M{"_id.key": key, "_id.operation": operation},
+					mongo.Pipeline{{{Key: "$set", Value: bson.M{"updated_at": bson.M{"$subtract": bson.A{"$updated_at", age.Milliseconds()}}}}}})
+				if err != nil {
+					t.Fatalf("Failed to age idempotency key %v", err)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"gorm.io/gorm"
+)
+
+// Kinds of errors returned by the repositories. Check them with errors.Is,
+// the service maps each kind to one gRPC status code.
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrAlreadyExists     = errors.New("already exists")
+	ErrConflict          = errors.New("conflict")
+	ErrInvalidTransition = errors.New("invalid status transition")
+	ErrPrecondition      = errors.New("failed precondition")
+	ErrInvalidArgument   = errors.New("invalid argument")
+	ErrPermissionDenied  = errors.New("permission denied")
+	ErrUnavailable       = errors.New("storage unavailable")
+	ErrDeadlineExceeded  = errors.New("deadline exceeded")
+	ErrCanceled          = errors.New("canceled")
+	ErrInternal          = errors.New("internal error")
+)
+
+// Error is a domain error with a stable reason code clients can branch on
+type Error struct {
+	// Kind is one of the Err* values above
+	Kind error
+	// Reason is an UPPER_SNAKE_CASE code that never changes once published, e.g. CAMPAIGN_NOT_FOUND
+	Reason  string
+	Message string
+	// Metadata is extra context for the reason, like the campaign id
+	Metadata map[string]string
+	// Violations explain which preconditions failed
+	Violations []Violation
+	// Err is the underlying cause, it is never shown to clients
+	Err error
+}
+
+// Violation is one failed precondition
+type Violation struct {
+	Type        string
+	Subject     string
+	Description string
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap lets errors.Is match both the kind and the cause
+func (e *Error) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+// newError creates a domain error of the given kind
+func newError(kind error, reason string, format string, args ...interface{}) *Error {
+	return &Error{Kind: kind, Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// with adds a metadata entry to the error
+func (e *Error) with(key string, value string) *Error {
+	if e.Metadata == nil {
+		e.Metadata = map[string]string{}
+	}
+	e.Metadata[key] = value
+	return e
+}
+
+// notFoundError maps a failed lookup: a missing row becomes ErrNotFound with reason,
+// anything else is a storage failure
+func notFoundError(err error, reason string, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &Error{Kind: ErrNotFound, Reason: reason, Message: message, Err: err}
+	}
+	return dbError(err, message)
+}
+
+// dbError classifies an error from the database driver. Lost connections are reported as
+// ErrUnavailable so clients retry, constraint and serialization errors as conflicts.
+func dbError(err error, message string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &Error{Kind: ErrNotFound, Reason: "NOT_FOUND", Message: message, Err: err}
+	}
+	// The caller gave up or the query timeout hit, checked first as both also look like network errors
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &Error{Kind: ErrDeadlineExceeded, Reason: "DEADLINE_EXCEEDED", Message: message, Err: err}
+	}
+	if errors.Is(err, context.Canceled) {
+		return &Error{Kind: ErrCanceled, Reason: "CANCELLED", Message: message, Err: err}
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == "57014":
+			// query_canceled, sent when the statement was cancelled for running too long
+			return &Error{Kind: ErrDeadlineExceeded, Reason: "DEADLINE_EXCEEDED", Message: message, Err: err}
+		case pgErr.Code == "23505":
+			return &Error{Kind: ErrAlreadyExists, Reason: "ALREADY_EXISTS", Message: message, Err: err}
+		case pgErr.Code == "40001" || pgErr.Code == "40P01":
+			return &Error{Kind: ErrConflict, Reason: "CONCURRENT_MODIFICATION", Message: message, Err: err}
+		case pgErr.Code[:2] == "08" || pgErr.Code[:2] == "53" || pgErr.Code[:2] == "57":
+			// connection exception, insufficient resources, operator intervention (e.g. shutdown)
+			return &Error{Kind: ErrUnavailable, Reason: "STORAGE_UNAVAILABLE", Message: message, Err: err}
+		}
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	if errors.As(err, &connectErr) || errors.As(err, &netErr) || errors.Is(err, driver.ErrBadConn) || pgconn.SafeToRetry(err) {
+		return &Error{Kind: ErrUnavailable, Reason: "STORAGE_UNAVAILABLE", Message: message, Err: err}
+	}
+	return &Error{Kind: ErrInternal, Reason: "INTERNAL", Message: message, Err: err}
+}
+
+// mongoError classifies an error from the MongoDB driver the same way dbError does for SQL errors
+func mongoError(err error, message string) error {
+	// No server could be reached in time, which is a storage outage rather than a slow query
+	if errors.As(err, &topology.ServerSelectionError{}) || errors.Is(err, topology.ErrServerSelectionTimeout) {
+		return &Error{Kind: ErrUnavailable, Reason: "STORAGE_UNAVAILABLE", Message: message, Err: err}
+	}
+
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return &Error{Kind: ErrNotFound, Reason: "NOT_FOUND", Message: message, Err: err}
+	case errors.Is(err, context.Canceled):
+		return &Error{Kind: ErrCanceled, Reason: "CANCELLED", Message: message, Err: err}
+	case mongo.IsTimeout(err):
+		return &Error{Kind: ErrDeadlineExceeded, Reason: "DEADLINE_EXCEEDED", Message: message, Err: err}
+	case mongo.IsDuplicateKeyError(err):
+		return &Error{Kind: ErrAlreadyExists, Reason: "ALREADY_EXISTS", Message: message, Err: err}
+	case mongo.IsNetworkError(err):
+		return &Error{Kind: ErrUnavailable, Reason: "STORAGE_UNAVAILABLE", Message: message, Err: err}
+	}
+	return &Error{Kind: ErrInternal, Reason: "INTERNAL", Message: message, Err: err}
+}
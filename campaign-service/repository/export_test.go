@@ -0,0 +1,16 @@
+package repository
+
+import "time"
+
+// AgeMemoryIdempotencyKey moves the last write of a key kept by NewMemoryIdempotencyRepository back by age
+func AgeMemoryIdempotencyKey(repo IdempotencyRepository, key string, operation string, age time.Duration) {
+	r := repo.(*memoryIdempotencyRepository)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := idempotencyKeyID{key: key, operation: operation}
+	if record, ok := r.keys[id]; ok {
+		record.UpdatedAt = record.UpdatedAt.Add(-age)
+		r.keys[id] = record
+	}
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// idempotencyKeyID identifies a key within the operation it was used for
+type idempotencyKeyID struct {
+	key       string
+	operation string
+}
+
+// memoryIdempotencyRepository keeps idempotency keys in memory, for local development and tests.
+type memoryIdempotencyRepository struct {
+	mu   sync.Mutex
+	keys map[idempotencyKeyID]models.IdempotencyKeyDB
+}
+
+// Constructor NewMemoryIdempotencyRepository creates and returns an empty in-memory IdempotencyRepository.
+func NewMemoryIdempotencyRepository() IdempotencyRepository {
+	return &memoryIdempotencyRepository{keys: map[idempotencyKeyID]models.IdempotencyKeyDB{}}
+}
+
+// ReserveKey claims key for operation. It returns true when the caller now owns the key,
+// otherwise it returns the existing record so a stored response can be replayed.
+func (r *memoryIdempotencyRepository) ReserveKey(ctx context.Context, key string, operation string, requestHash string) (models.IdempotencyKeyDB, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return models.IdempotencyKeyDB{}, false, dbError(err, "Error reserving idempotency key")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Insert the key, or take over a stale reservation of the same request
+	now := time.Now()
+	id := idempotencyKeyID{key: key, operation: operation}
+	existing, ok := r.keys[id]
+	if ok && (existing.Response != nil || existing.RequestHash != requestHash || !existing.UpdatedAt.Before(now.Add(-idempotencyLockTimeout))) {
+		existing.Response = slices.Clone(existing.Response)
+		return existing, false, nil
+	}
+
+	record := models.IdempotencyKeyDB{
+		Key:         key,
+		Operation:   operation,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	r.keys[id] = record
+	return record, true, nil
+}
+
+// CompleteKey stores the response of the request that reserved the key
+func (r *memoryIdempotencyRepository) CompleteKey(ctx context.Context, key string, operation string, response []byte) error {
+	if err := ctx.Err(); err != nil {
+		return dbError(err, "Error storing idempotent response")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := idempotencyKeyID{key: key, operation: operation}
+	if record, ok := r.keys[id]; ok {
+		record.Response = slices.Clone(response)
+		record.UpdatedAt = time.Now()
+		r.keys[id] = record
+	}
+	return nil
+}
+
+// ReleaseKey removes a reservation whose request failed so it can be retried
+func (r *memoryIdempotencyRepository) ReleaseKey(ctx context.Context, key string, operation string) error {
+	if err := ctx.Err(); err != nil {
+		return dbError(err, "Error releasing idempotency key")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := idempotencyKeyID{key: key, operation: operation}
+	if record, ok := r.keys[id]; ok && record.Response == nil {
+		delete(r.keys, id)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// mongoIdempotencyRepository is the MongoDB implementation of IdempotencyRepository.
+type mongoIdempotencyRepository struct {
+	keys *mongo.Collection
+}
+
+// Constructor NewMongoIdempotencyRepository creates and returns a new instance of mongoIdempotencyRepository,
+// injecting the Mongo database.
+func NewMongoIdempotencyRepository(db *mongo.Database) IdempotencyRepository {
+	return &mongoIdempotencyRepository{keys: db.Collection(idempotencyCollection)}
+}
+
+// ReserveKey claims key for operation. It returns true when the caller now owns the key,
+// otherwise it returns the existing record so a stored response can be replayed.
+func (r *mongoIdempotencyRepository) ReserveKey(ctx context.Context, key string, operation string, requestHash string) (models.IdempotencyKeyDB, bool, error) {
+	now := bsonTime(time.Now())
+	document := idempotencyDocument{
+		ID:          idempotencyDocumentID{Key: key, Operation: operation},
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	// Insert the key, the unique _id makes sure only one request gets it
+	_, err := r.keys.InsertOne(ctx, document)
+	if err == nil {
+		return document.model(), true, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return models.IdempotencyKeyDB{}, false, mongoError(err, "Error reserving idempotency key")
+	}
+
+	// Take over a stale reservation of the same request
+	var existing idempotencyDocument
+	err = r.keys.FindOneAndUpdate(ctx, bson.M{
+		"_id":          document.ID,
+		"response":     nil,
+		"request_hash": requestHash,
+		"updated_at":   bson.M{"$lt": now.Add(-idempotencyLockTimeout)},
+	}, bson.M{"$set": bson.M{"created_at": now, "updated_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&existing)
+	if err == nil {
+		return existing.model(), true, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return models.IdempotencyKeyDB{}, false, mongoError(err, "Error reserving idempotency key")
+	}
+
+	// The key is taken, return what was stored for it
+	if err := r.keys.FindOne(ctx, bson.M{"_id": document.ID}).Decode(&existing); err != nil {
+		return models.IdempotencyKeyDB{}, false, mongoError(err, "Error reading idempotency key")
+	}
+	return existing.model(), false, nil
+}
+
+// CompleteKey stores the response of the request that reserved the key
+func (r *mongoIdempotencyRepository) CompleteKey(ctx context.Context, key string, operation string, response []byte) error {
+	_, err := r.keys.UpdateOne(ctx,
+		bson.M{"_id": idempotencyDocumentID{Key: key, Operation: operation}},
+		bson.M{"$set": bson.M{"response": response, "updated_at": bsonTime(time.Now())}})
+	if err != nil {
+		return mongoError(err, "Error storing idempotent response")
+	}
+	return nil
+}
+
+// ReleaseKey removes a reservation whose request failed so it can be retried
+func (r *mongoIdempotencyRepository) ReleaseKey(ctx context.Context, key string, operation string) error {
+	_, err := r.keys.DeleteOne(ctx, bson.M{"_id": idempotencyDocumentID{Key: key, Operation: operation}, "response": nil})
+	if err != nil {
+		return mongoError(err, "Error releasing idempotency key")
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// idempotencyLockTimeout is how long a key stays reserved without a stored response
+// before another request with the same payload may take it over (e.g. after a crash).
+const idempotencyLockTimeout = 5 * time.Minute
+
+// IdempotencyRepository stores idempotency keys and the responses of the requests that used them.
+type IdempotencyRepository interface {
+	ReserveKey(ctx context.Context, key string, operation string, requestHash string) (models.IdempotencyKeyDB, bool, error)
+	CompleteKey(ctx context.Context, key string, operation string, response []byte) error
+	ReleaseKey(ctx context.Context, key string, operation string) error
+}
+
+// idempotencyRepository is the gorm implementation of IdempotencyRepository.
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+// Constructor NewIdempotencyRepository creates and returns a new instance of idempotencyRepository,
+// injecting the gorm database connection.
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+// ReserveKey claims key for operation. It returns true when the caller now owns the key,
+// otherwise it returns the existing record so a stored response can be replayed.
+func (r *idempotencyRepository) ReserveKey(ctx context.Context, key string, operation string, requestHash string) (models.IdempotencyKeyDB, bool, error) {
+	record := models.IdempotencyKeyDB{
+		Key:         key,
+		Operation:   operation,
+		RequestHash: requestHash,
+	}
+
+	// Insert the key, or take over a stale reservation of the same request
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}, {Name: "operation"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"created_at": time.Now(), "updated_at": time.Now()}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "idempotency_keys.response IS NULL"},
+			clause.Expr{SQL: "idempotency_keys.request_hash = ?", Vars: []interface{}{requestHash}},
+			clause.Expr{SQL: "idempotency_keys.updated_at < ?", Vars: []interface{}{time.Now().Add(-idempotencyLockTimeout)}},
+		}},
+	}).Create(&record)
+	if result.Error != nil {
+		return models.IdempotencyKeyDB{}, false, dbError(result.Error, "Error reserving idempotency key")
+	}
+	if result.RowsAffected == 1 {
+		return record, true, nil
+	}
+
+	// The key is taken, return what was stored for it
+	var existing models.IdempotencyKeyDB
+	if err := r.db.WithContext(ctx).First(&existing, "key=? AND operation=?", key, operation).Error; err != nil {
+		return models.IdempotencyKeyDB{}, false, dbError(err, "Error reading idempotency key")
+	}
+	return existing, false, nil
+}
+
+// CompleteKey stores the response of the request that reserved the key
+func (r *idempotencyRepository) CompleteKey(ctx context.Context, key string, operation string, response []byte) error {
+	if err := r.db.WithContext(ctx).Model(&models.IdempotencyKeyDB{}).Where("key=? AND operation=?", key, operation).Update("response", response).Error; err != nil {
+		return dbError(err, "Error storing idempotent response")
+	}
+	return nil
+}
+
+// ReleaseKey removes a reservation whose request failed so it can be retried
+func (r *idempotencyRepository) ReleaseKey(ctx context.Context, key string, operation string) error {
+	if err := r.db.WithContext(ctx).Where("key=? AND operation=? AND response IS NULL", key, operation).Delete(&models.IdempotencyKeyDB{}).Error; err != nil {
+		return dbError(err, "Error releasing idempotency key")
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// MongoDB collections, named after the Postgres tables they replace
+const (
+	campaignsCollection       = "campaigns"
+	contributionsCollection   = "campaign_contributions"
+	reversalsCollection       = "campaign_reversals"
+	statusChangesCollection   = "campaign_status_changes"
+	campaignUpdatesCollection = "campaign_updates"
+	idempotencyCollection     = "idempotency_keys"
+)
+
+// mongoIndexes are the secondary indexes of each collection. Lists filter campaigns by owner and
+// status, the completion job scans by status and deadline.
+var mongoIndexes = map[string][]mongo.IndexModel{
+	campaignsCollection: {
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}}},
+		{Keys: bson.D{{Key: "deadline", Value: 1}}},
+	},
+	contributionsCollection: {
+		{Keys: bson.D{{Key: "campaign_id", Value: 1}}},
+	},
+	reversalsCollection: {
+		{Keys: bson.D{{Key: "campaign_id", Value: 1}}},
+		{Keys: bson.D{{Key: "contribution_id", Value: 1}}},
+	},
+	statusChangesCollection: {
+		{Keys: bson.D{{Key: "campaign_id", Value: 1}}},
+	},
+	campaignUpdatesCollection: {
+		{Keys: bson.D{{Key: "campaign_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	},
+}
+
+// EnsureMongoIndexes creates the indexes the Mongo repositories rely on. Existing indexes are kept,
+// so it is safe to call on every start.
+func EnsureMongoIndexes(ctx context.Context, db *mongo.Database) error {
+	for collection, indexes := range mongoIndexes {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes); err != nil {
+			return mongoError(err, "Error creating indexes of "+collection)
+		}
+	}
+	return nil
+}
+
+// campaignDocument is a campaign as stored in the campaigns collection
+type campaignDocument struct {
+	ID                   string     `bson:"_id"`
+	UserID               int32      `bson:"user_id"`
+	Title                string     `bson:"title"`
+	Description          string     `bson:"description"`
+	Currency             string     `bson:"currency"`
+	TargetAmount         int64      `bson:"target_amount"`
+	CollectedAmount      int64      `bson:"collected_amount"`
+	Deadline             time.Time  `bson:"deadline"`
+	Status               string     `bson:"status"`
+	Category             string     `bson:"category"`
+	MinDonation          int64      `bson:"min_donation"`
+	AutoCompleteOnTarget bool       `bson:"auto_complete_on_target"`
+	RejectionReason      string     `bson:"rejection_reason"`
+	Version              int64      `bson:"version"`
+	CreatedAt            time.Time  `bson:"created_at"`
+	UpdatedAt            time.Time  `bson:"updated_at"`
+	DeletedAt            *time.Time `bson:"deleted_at"`
+}
+
+// newCampaignDocument converts campaign, cutting its times to the millisecond precision of BSON
+// so the returned campaign equals what is read back
+func newCampaignDocument(campaign models.CampaignDB) campaignDocument {
+	return campaignDocument{
+		ID:                   campaign.ID,
+		UserID:               campaign.UserID,
+		Title:                campaign.Title,
+		Description:          campaign.Description,
+		Currency:             campaign.Currency,
+		TargetAmount:         campaign.TargetAmount,
+		CollectedAmount:      campaign.CollectedAmount,
+		Deadline:             bsonTime(campaign.Deadline),
+		Status:               campaign.Status,
+		Category:             campaign.Category,
+		MinDonation:          campaign.MinDonation,
+		AutoCompleteOnTarget: campaign.AutoCompleteOnTarget,
+		RejectionReason:      campaign.RejectionReason,
+		Version:              campaign.Version,
+		CreatedAt:            bsonTime(campaign.CreatedAt),
+		UpdatedAt:            bsonTime(campaign.UpdatedAt),
+		DeletedAt:            bsonDeletedAt(campaign.DeletedAt),
+	}
+}
+
+func (d campaignDocument) model() models.CampaignDB {
+	return models.CampaignDB{
+		ID:                   d.ID,
+		UserID:               d.UserID,
+		Title:                d.Title,
+		Description:          d.Description,
+		Currency:             d.Currency,
+		TargetAmount:         d.TargetAmount,
+		CollectedAmount:      d.CollectedAmount,
+		Deadline:             d.Deadline,
+		Status:               d.Status,
+		Category:             d.Category,
+		MinDonation:          d.MinDonation,
+		AutoCompleteOnTarget: d.AutoCompleteOnTarget,
+		RejectionReason:      d.RejectionReason,
+		Version:              d.Version,
+		CreatedAt:            d.CreatedAt,
+		UpdatedAt:            d.UpdatedAt,
+		DeletedAt:            modelDeletedAt(d.DeletedAt),
+	}
+}
+
+// fields returns the named fields of the document, ready for a $set
+func (d campaignDocument) fields(names ...string) (bson.M, error) {
+	raw, err := bson.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	var all bson.M
+	if err := bson.Unmarshal(raw, &all); err != nil {
+		return nil, err
+	}
+
+	fields := bson.M{}
+	for _, name := range names {
+		fields[name] = all[name]
+	}
+	return fields, nil
+}
+
+// contributionDocument is a ledger entry of the campaign_contributions collection.
+// ReversedAmount is the part already taken back, kept here so refunds can be checked atomically.
+type contributionDocument struct {
+	ID             string    `bson:"_id"`
+	CampaignID     string    `bson:"campaign_id"`
+	Currency       string    `bson:"currency"`
+	Amount         int64     `bson:"amount"`
+	Source         string    `bson:"source"`
+	ReversedAmount int64     `bson:"reversed_amount"`
+	CreatedAt      time.Time `bson:"created_at"`
+}
+
+// reversalDocument is a ledger entry of the campaign_reversals collection
+type reversalDocument struct {
+	ID             string    `bson:"_id"`
+	CampaignID     string    `bson:"campaign_id"`
+	ContributionID string    `bson:"contribution_id"`
+	Currency       string    `bson:"currency"`
+	Amount         int64     `bson:"amount"`
+	Reason         string    `bson:"reason"`
+	CreatedAt      time.Time `bson:"created_at"`
+}
+
+// statusChangeDocument is an entry of the campaign_status_changes collection
+type statusChangeDocument struct {
+	CampaignID string    `bson:"campaign_id"`
+	UserID     int32     `bson:"user_id"`
+	FromStatus string    `bson:"from_status"`
+	ToStatus   string    `bson:"to_status"`
+	Reason     string    `bson:"reason"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+func (d statusChangeDocument) model() models.CampaignStatusChangeDB {
+	return models.CampaignStatusChangeDB{
+		CampaignID: d.CampaignID,
+		UserID:     d.UserID,
+		FromStatus: d.FromStatus,
+		ToStatus:   d.ToStatus,
+		Reason:     d.Reason,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+// campaignUpdateDocument is a news post of the campaign_updates collection
+type campaignUpdateDocument struct {
+	ID         string     `bson:"_id"`
+	CampaignID string     `bson:"campaign_id"`
+	UserID     int32      `bson:"user_id"`
+	Title      string     `bson:"title"`
+	Content    string     `bson:"content"`
+	CreatedAt  time.Time  `bson:"created_at"`
+	UpdatedAt  time.Time  `bson:"updated_at"`
+	DeletedAt  *time.Time `bson:"deleted_at"`
+}
+
+func newCampaignUpdateDocument(update models.CampaignUpdateDB) campaignUpdateDocument {
+	return campaignUpdateDocument{
+		ID:         update.ID,
+		CampaignID: update.CampaignID,
+		UserID:     update.UserID,
+		Title:      update.Title,
+		Content:    update.Content,
+		CreatedAt:  bsonTime(update.CreatedAt),
+		UpdatedAt:  bsonTime(update.UpdatedAt),
+		DeletedAt:  bsonDeletedAt(update.DeletedAt),
+	}
+}
+
+func (d campaignUpdateDocument) model() models.CampaignUpdateDB {
+	return models.CampaignUpdateDB{
+		ID:         d.ID,
+		CampaignID: d.CampaignID,
+		UserID:     d.UserID,
+		Title:      d.Title,
+		Content:    d.Content,
+		CreatedAt:  d.CreatedAt,
+		UpdatedAt:  d.UpdatedAt,
+		DeletedAt:  modelDeletedAt(d.DeletedAt),
+	}
+}
+
+// idempotencyDocumentID is the compound key of an idempotency key document
+type idempotencyDocumentID struct {
+	Key       string `bson:"key"`
+	Operation string `bson:"operation"`
+}
+
+// idempotencyDocument is an entry of the idempotency_keys collection
+type idempotencyDocument struct {
+	ID          idempotencyDocumentID `bson:"_id"`
+	RequestHash string                `bson:"request_hash"`
+	Response    []byte                `bson:"response"`
+	CreatedAt   time.Time             `bson:"created_at"`
+	UpdatedAt   time.Time             `bson:"updated_at"`
+}
+
+func (d idempotencyDocument) model() models.IdempotencyKeyDB {
+	return models.IdempotencyKeyDB{
+		Key:         d.ID.Key,
+		Operation:   d.ID.Operation,
+		RequestHash: d.RequestHash,
+		Response:    d.Response,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+// bsonTime cuts t to the millisecond precision BSON dates have
+func bsonTime(t time.Time) time.Time {
+	return t.Truncate(time.Millisecond)
+}
+
+func bsonDeletedAt(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	t := bsonTime(deletedAt.Time)
+	return &t
+}
+
+func modelDeletedAt(deletedAt *time.Time) gorm.DeletedAt {
+	if deletedAt == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: *deletedAt, Valid: true}
+}
+
+// undoMongoWrite reverts a write whose follow-up failed. MongoDB without replica sets has no
+// multi-document transactions, so the ledger is kept consistent by compensating writes;
+// a failed one is logged and shows up as drift in ReconcileCampaign.
+func undoMongoWrite(ctx context.Context, description string, undo func(ctx context.Context) error) {
+	if err := undo(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("Failed to undo %v: %v", description, err)
+	}
+}
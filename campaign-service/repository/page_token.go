@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageCursor is the position of the last row of a page. It is serialized into an
+// opaque page token so clients cannot depend on its layout. Exactly one of Time, Integer
+// and Number holds the sort key, amounts are kept as Integer so they survive the round
+// trip through JSON without losing precision.
+type pageCursor struct {
+	SortBy     string     `json:"s"`
+	Descending bool       `json:"d"`
+	Time       *time.Time `json:"t,omitempty"`
+	Integer    *int64     `json:"c,omitempty"`
+	Number     *float64   `json:"n,omitempty"`
+	ID         string     `json:"i"`
+}
+
+// sortValue returns the sort key of the cursor, or nil when it has none
+func (c pageCursor) sortValue() interface{} {
+	switch {
+	case c.Time != nil:
+		return *c.Time
+	case c.Integer != nil:
+		return *c.Integer
+	case c.Number != nil:
+		return *c.Number
+	}
+	return nil
+}
+
+func encodePageToken(cursor pageCursor) string {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Invalid page token")
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == "" {
+		return nil, newError(ErrInvalidArgument, "INVALID_PAGE_TOKEN", "Invalid page token")
+	}
+	return &cursor, nil
+}
+
+// normalizePageSize applies the default and upper bound to a requested page size
+func normalizePageSize(size int) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
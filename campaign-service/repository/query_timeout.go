@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// queryTimeoutCancelKey stores the cancel function of a statement's timeout context
+const queryTimeoutCancelKey = "campaign:query_timeout_cancel"
+
+// queryTimeout is a gorm plugin that bounds every statement by a timeout, on top of any
+// deadline the caller's context already has
+type queryTimeout struct {
+	timeout time.Duration
+}
+
+// NewQueryTimeout returns a gorm plugin limiting each SQL statement to timeout.
+// Register it with db.Use. Statements inside a transaction are limited one by one.
+func NewQueryTimeout(timeout time.Duration) gorm.Plugin {
+	return &queryTimeout{timeout: timeout}
+}
+
+func (p *queryTimeout) Name() string {
+	return "campaign:query_timeout"
+}
+
+// Initialize wraps whole processors, so the transaction, hooks and associations of a statement share its timeout
+func (p *queryTimeout) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Create().Before("*").Register("campaign:timeout_start", p.start); err != nil {
+		return err
+	}
+	if err := db.Callback().Create().After("*").Register("campaign:timeout_stop", p.stop); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().Before("*").Register("campaign:timeout_start", p.start); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().After("*").Register("campaign:timeout_stop", p.stop); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("*").Register("campaign:timeout_start", p.start); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().After("*").Register("campaign:timeout_stop", p.stop); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().Before("*").Register("campaign:timeout_start", p.start); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().After("*").Register("campaign:timeout_stop", p.stop); err != nil {
+		return err
+	}
+	// Rows of Row, Rows and Scan are read after the callbacks ran, so nothing could cancel a timeout
+	// started for them. They are left out and only bounded by the caller's context.
+	if err := db.Callback().Raw().Before("*").Register("campaign:timeout_start", p.start); err != nil {
+		return err
+	}
+	return db.Callback().Raw().After("*").Register("campaign:timeout_stop", p.stop)
+}
+
+// start replaces the statement context with one that expires after the timeout
+func (p *queryTimeout) start(db *gorm.DB) {
+	ctx, cancel := context.WithTimeout(db.Statement.Context, p.timeout)
+	db.Statement.Context = ctx
+	db.InstanceSet(queryTimeoutCancelKey, cancel)
+}
+
+// stop releases the timer of start once the statement is done
+func (p *queryTimeout) stop(db *gorm.DB) {
+	if cancel, ok := db.InstanceGet(queryTimeoutCancelKey); ok {
+		cancel.(context.CancelFunc)()
+	}
+}
@@ -0,0 +1,929 @@
+// Package repotest is a conformance suite for the repositories of package repository. Every backend
+// runs the same cases from its own _test.go file, so a new implementation proves it behaves like the
+// existing ones: same defaults, same error kinds, same visibility of drafts and deleted campaigns.
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+)
+
+// CampaignRepositoryFactory returns an empty repository for a single test. Backends that share
+// a database between tests have to clear it here.
+type CampaignRepositoryFactory func(t *testing.T) repository.CampaignRepository
+
+// RunCampaignRepository runs every conformance case against the repositories made by newRepo
+func RunCampaignRepository(t *testing.T, newRepo CampaignRepositoryFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, repo repository.CampaignRepository)
+	}{
+		{"CreateAndGet", testCreateAndGet},
+		{"CreateDuplicate", testCreateDuplicate},
+		{"GetMissing", testGetMissing},
+		{"Update", testUpdate},
+		{"UpdateGuards", testUpdateGuards},
+		{"DraftCurrency", testDraftCurrency},
+		{"Delete", testDelete},
+		{"SoftDeleteVisibility", testSoftDeleteVisibility},
+		{"StatusTransitions", testStatusTransitions},
+		{"StatusGuards", testStatusGuards},
+		{"ActingUser", testActingUser},
+		{"Review", testReview},
+		{"ForceCancel", testForceCancel},
+		{"UserFiltering", testUserFiltering},
+		{"ListFilters", testListFilters},
+		{"Pagination", testPagination},
+		{"PageTokens", testPageTokens},
+		{"LargeAmountPages", testLargeAmountPages},
+		{"Search", testSearch},
+		{"SearchPages", testSearchPages},
+		{"Contributions", testContributions},
+		{"Reversals", testReversals},
+		{"Reconcile", testReconcile},
+		{"CompleteDueCampaigns", testCompleteDueCampaigns},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			val.run(t, newRepo(t))
+		})
+	}
+}
+
+func testCreateAndGet(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := newCampaign(1, "")
+	campaign.Currency = ""
+
+	created := mustCreate(t, repo, campaign)
+	if created.Status != models.StatusDraft {
+		t.Errorf("status = %q, want the default %q", created.Status, models.StatusDraft)
+	}
+	if created.Currency != "IDR" {
+		t.Errorf("currency = %q, want the default IDR", created.Currency)
+	}
+	if created.Version != 1 {
+		t.Errorf("version = %d, want 1", created.Version)
+	}
+
+	got, err := repo.GetCampaignByID(ctx, campaign.ID)
+	if err != nil {
+		t.Fatalf("GetCampaignByID: %v", err)
+	}
+	if got.ID != campaign.ID || got.UserID != campaign.UserID || got.Title != campaign.Title ||
+		got.Description != campaign.Description || got.TargetAmount != campaign.TargetAmount ||
+		got.MinDonation != campaign.MinDonation || got.Category != campaign.Category {
+		t.Errorf("GetCampaignByID = %+v, want the created %+v", got, created)
+	}
+	if got.Version != created.Version || got.Status != created.Status {
+		t.Errorf("GetCampaignByID version/status = %d/%q, want %d/%q", got.Version, got.Status, created.Version, created.Status)
+	}
+	if got.CreatedAt.IsZero() || got.DeletedAt.Valid {
+		t.Errorf("GetCampaignByID created_at/deleted_at = %v/%v, want set/unset", got.CreatedAt, got.DeletedAt)
+	}
+}
+
+func testCreateDuplicate(t *testing.T, repo repository.CampaignRepository) {
+	campaign := mustCreate(t, repo, newCampaign(1, models.StatusDraft))
+	_, err := repo.CreateCampaign(context.Background(), campaign)
+	expectKind(t, err, repository.ErrAlreadyExists)
+}
+
+func testGetMissing(t *testing.T, repo repository.CampaignRepository) {
+	_, err := repo.GetCampaignByID(context.Background(), uuid.NewString())
+	expectKind(t, err, repository.ErrNotFound)
+}
+
+func testUpdate(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+
+	// Listed fields are written even when empty, the others are left alone
+	changes := models.CampaignDB{Title: "Renamed", Description: "", TargetAmount: 999, Version: campaign.Version}
+	updated, err := repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, changes, []string{"title", "description"})
+	if err != nil {
+		t.Fatalf("UpdateCampaignByID: %v", err)
+	}
+	if updated.Title != "Renamed" || updated.Description != "" {
+		t.Errorf("title/description = %q/%q, want Renamed/empty", updated.Title, updated.Description)
+	}
+	if updated.TargetAmount != campaign.TargetAmount {
+		t.Errorf("target amount = %d, want the unlisted field to stay %d", updated.TargetAmount, campaign.TargetAmount)
+	}
+	if updated.Version <= campaign.Version {
+		t.Errorf("version = %d, want it bumped from %d", updated.Version, campaign.Version)
+	}
+
+	got := mustGet(t, repo, campaign.ID)
+	if got.Title != "Renamed" || got.Version != updated.Version {
+		t.Errorf("stored title/version = %q/%d, want Renamed/%d", got.Title, got.Version, updated.Version)
+	}
+}
+
+func testUpdateGuards(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+	changes := models.CampaignDB{Title: "Renamed", Version: campaign.Version}
+
+	_, err := repo.UpdateCampaignByID(ctx, uuid.NewString(), campaign.UserID, campaign.UserID, changes, []string{"title"})
+	expectKind(t, err, repository.ErrNotFound)
+
+	_, err = repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID+1, campaign.UserID+1, changes, []string{"title"})
+	expectKind(t, err, repository.ErrPermissionDenied)
+
+	stale := changes
+	stale.Version = campaign.Version + 1
+	_, err = repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, stale, []string{"title"})
+	expectKind(t, err, repository.ErrConflict)
+
+	// Active campaigns keep their currency
+	currency := changes
+	currency.Currency = "USD"
+	_, err = repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, currency, []string{"currency"})
+	expectKind(t, err, repository.ErrInvalidArgument)
+
+	// The minimum donation cannot exceed the target, whichever of the two is written
+	aboveTarget := changes
+	aboveTarget.MinDonation = campaign.TargetAmount + 1
+	_, err = repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, aboveTarget, []string{"min_donation"})
+	expectKind(t, err, repository.ErrInvalidArgument)
+	belowMinimum := changes
+	belowMinimum.TargetAmount = campaign.MinDonation - 1
+	_, err = repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, belowMinimum, []string{"target_amount"})
+	expectKind(t, err, repository.ErrInvalidArgument)
+
+	// Status changes through an update follow the status rules
+	completed := changes
+	completed.Status = models.StatusCompleted
+	_, err = repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, completed, []string{"status"})
+	expectKind(t, err, repository.ErrInvalidTransition)
+
+	// Campaigns under review, completed or cancelled cannot be edited
+	for _, status := range []string{models.StatusPendingReview, models.StatusCompleted, models.StatusCancelled} {
+		locked := mustCreate(t, repo, newCampaign(1, status))
+		_, err := repo.UpdateCampaignByID(ctx, locked.ID, locked.UserID, locked.UserID, models.CampaignDB{Title: "Renamed", Version: locked.Version}, []string{"title"})
+		expectKind(t, err, repository.ErrPrecondition)
+	}
+
+	if got := mustGet(t, repo, campaign.ID); got.Title != campaign.Title || got.Version != campaign.Version {
+		t.Errorf("rejected updates changed the campaign to %q/%d", got.Title, got.Version)
+	}
+}
+
+func testDraftCurrency(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	draft := mustCreate(t, repo, newCampaign(1, models.StatusDraft))
+
+	// Switching with a single amount would leave the other one in the units of the old currency
+	for _, fields := range [][]string{{"currency"}, {"target_amount", "currency"}, {"min_donation", "currency"}} {
+		_, err := repo.UpdateCampaignByID(ctx, draft.ID, draft.UserID, draft.UserID, models.CampaignDB{TargetAmount: 1_000, MinDonation: 10, Currency: "USD", Version: draft.Version}, fields)
+		expectKind(t, err, repository.ErrInvalidArgument)
+	}
+	if got := mustGet(t, repo, draft.ID); got.Currency != draft.Currency || got.TargetAmount != draft.TargetAmount {
+		t.Fatalf("rejected currency changes stored %v %d", got.Currency, got.TargetAmount)
+	}
+
+	updated, err := repo.UpdateCampaignByID(ctx, draft.ID, draft.UserID, draft.UserID, models.CampaignDB{TargetAmount: 1_000, MinDonation: 10, Currency: "USD", Version: draft.Version}, []string{"target_amount", "min_donation", "currency"})
+	if err != nil {
+		t.Fatalf("UpdateCampaignByID with both amounts: %v", err)
+	}
+	if updated.Currency != "USD" || updated.TargetAmount != 1_000 || updated.MinDonation != 10 {
+		t.Errorf("updated to %v %d/%d, want USD 1000/10", updated.Currency, updated.TargetAmount, updated.MinDonation)
+	}
+
+	// Amounts sent in the stored currency do not need each other
+	_, err = repo.UpdateCampaignByID(ctx, draft.ID, draft.UserID, draft.UserID, models.CampaignDB{TargetAmount: 2_000, Currency: "USD", Version: updated.Version}, []string{"target_amount", "currency"})
+	if err != nil {
+		t.Errorf("UpdateCampaignByID in the same currency: %v", err)
+	}
+}
+
+func testDelete(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+
+	expectKind(t, repo.DeleteCampaignByID(ctx, campaign.ID, campaign.UserID+1, campaign.UserID+1, campaign.Version), repository.ErrPermissionDenied)
+	expectKind(t, repo.DeleteCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, campaign.Version+1), repository.ErrConflict)
+	expectKind(t, repo.DeleteCampaignByID(ctx, uuid.NewString(), campaign.UserID, campaign.UserID, 1), repository.ErrNotFound)
+
+	if err := repo.DeleteCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, campaign.Version); err != nil {
+		t.Fatalf("DeleteCampaignByID: %v", err)
+	}
+	_, err := repo.GetCampaignByID(ctx, campaign.ID)
+	expectKind(t, err, repository.ErrNotFound)
+	expectKind(t, repo.DeleteCampaignByID(ctx, campaign.ID, campaign.UserID, campaign.UserID, campaign.Version+1), repository.ErrNotFound)
+
+	// Completed campaigns cannot be cancelled, so they cannot be deleted either
+	completed := mustCreate(t, repo, newCampaign(1, models.StatusCompleted))
+	expectKind(t, repo.DeleteCampaignByID(ctx, completed.ID, completed.UserID, completed.UserID, completed.Version), repository.ErrInvalidTransition)
+}
+
+func testSoftDeleteVisibility(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	kept := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+	deleted := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+	if err := repo.DeleteCampaignByID(ctx, deleted.ID, deleted.UserID, deleted.UserID, deleted.Version); err != nil {
+		t.Fatalf("DeleteCampaignByID: %v", err)
+	}
+
+	owned, err := repo.GetCampaignsByUserID(ctx, 1, repository.CampaignListOptions{})
+	if err != nil {
+		t.Fatalf("GetCampaignsByUserID: %v", err)
+	}
+	expectIDs(t, "GetCampaignsByUserID", owned.Campaigns, kept.ID)
+	if owned.TotalCount != 1 {
+		t.Errorf("GetCampaignsByUserID total = %d, want 1", owned.TotalCount)
+	}
+
+	listed, err := repo.ListCampaigns(ctx, repository.CampaignListOptions{})
+	if err != nil {
+		t.Fatalf("ListCampaigns: %v", err)
+	}
+	expectIDs(t, "ListCampaigns", listed.Campaigns, kept.ID)
+
+	// Admins still see deleted campaigns, cancelled by the delete
+	all, err := repo.ListCampaigns(ctx, repository.CampaignListOptions{IncludeDeleted: true})
+	if err != nil {
+		t.Fatalf("ListCampaigns including deleted: %v", err)
+	}
+	expectIDs(t, "ListCampaigns including deleted", all.Campaigns, kept.ID, deleted.ID)
+	for _, val := range all.Campaigns {
+		if val.ID == deleted.ID && (val.Status != models.StatusCancelled || !val.DeletedAt.Valid) {
+			t.Errorf("deleted campaign status/deleted_at = %q/%v, want cancelled/set", val.Status, val.DeletedAt)
+		}
+	}
+
+	_, err = repo.ChangeCampaignStatus(ctx, deleted.ID, deleted.UserID, deleted.UserID, deleted.Version+1, models.StatusActive, "")
+	expectKind(t, err, repository.ErrNotFound)
+}
+
+func testStatusTransitions(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+
+	steps := []string{models.StatusPaused, models.StatusActive, models.StatusCancelled}
+	for _, to := range steps {
+		changed, err := repo.ChangeCampaignStatus(ctx, campaign.ID, campaign.UserID, campaign.UserID, campaign.Version, to, "testing")
+		if err != nil {
+			t.Fatalf("ChangeCampaignStatus to %v: %v", to, err)
+		}
+		if changed.Status != to || changed.Version != campaign.Version+1 {
+			t.Fatalf("status/version = %q/%d, want %q/%d", changed.Status, changed.Version, to, campaign.Version+1)
+		}
+		campaign = changed
+	}
+	if got := mustGet(t, repo, campaign.ID); got.Status != models.StatusCancelled || got.Version != campaign.Version {
+		t.Errorf("stored status/version = %q/%d, want cancelled/%d", got.Status, got.Version, campaign.Version)
+	}
+
+	// Cancelled is final
+	_, err := repo.ChangeCampaignStatus(ctx, campaign.ID, campaign.UserID, campaign.UserID, campaign.Version, models.StatusActive, "")
+	expectKind(t, err, repository.ErrInvalidTransition)
+}
+
+func testStatusGuards(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	draft := mustCreate(t, repo, newCampaign(1, models.StatusDraft))
+
+	// Drafts go through review before they go live
+	_, err := repo.ChangeCampaignStatus(ctx, draft.ID, draft.UserID, draft.UserID, draft.Version, models.StatusActive, "")
+	expectKind(t, err, repository.ErrInvalidTransition)
+
+	// Only the owner changes the status, with the current version
+	_, err = repo.ChangeCampaignStatus(ctx, draft.ID, draft.UserID+1, draft.UserID+1, draft.Version, models.StatusPendingReview, "")
+	expectKind(t, err, repository.ErrPermissionDenied)
+	_, err = repo.ChangeCampaignStatus(ctx, draft.ID, draft.UserID, draft.UserID, draft.Version+1, models.StatusPendingReview, "")
+	expectKind(t, err, repository.ErrConflict)
+
+	// Incomplete drafts cannot be submitted
+	incomplete := newCampaign(1, models.StatusDraft)
+	incomplete.Title = ""
+	incomplete.TargetAmount = 0
+	incomplete = mustCreate(t, repo, incomplete)
+	_, err = repo.ChangeCampaignStatus(ctx, incomplete.ID, incomplete.UserID, incomplete.UserID, incomplete.Version, models.StatusPendingReview, "")
+	expectKind(t, err, repository.ErrPrecondition)
+
+	// Campaigns past their deadline cannot take donations again
+	expired := newCampaign(1, models.StatusPaused)
+	expired.Deadline = time.Now().Add(-48 * time.Hour)
+	expired = mustCreate(t, repo, expired)
+	_, err = repo.ChangeCampaignStatus(ctx, expired.ID, expired.UserID, expired.UserID, expired.Version, models.StatusActive, "")
+	expectKind(t, err, repository.ErrPrecondition)
+
+	// Owners cannot approve or reject their own campaign, only moderators review it
+	pending := mustCreate(t, repo, newCampaign(1, models.StatusPendingReview))
+	for _, to := range []string{models.StatusActive, models.StatusRejected} {
+		_, err = repo.ChangeCampaignStatus(ctx, pending.ID, pending.UserID, pending.UserID, pending.Version, to, "")
+		expectKind(t, err, repository.ErrInvalidTransition)
+	}
+	_, err = repo.UpdateCampaignByID(ctx, pending.ID, pending.UserID, pending.UserID, models.CampaignDB{Status: models.StatusActive, Version: pending.Version}, []string{"status"})
+	if err == nil {
+		t.Error("UpdateCampaignByID moved a campaign under review to active")
+	}
+	if got := mustGet(t, repo, pending.ID); got.Status != models.StatusPendingReview {
+		t.Errorf("owner moved the campaign under review to %q", got.Status)
+	}
+
+	if got := mustGet(t, repo, draft.ID); got.Status != models.StatusDraft || got.Version != draft.Version {
+		t.Errorf("rejected changes moved the draft to %q/%d", got.Status, got.Version)
+	}
+}
+
+// Admins act on campaigns of other users, the history has to name the admin rather than the owner
+func testActingUser(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	const adminID = 900
+	campaign := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+
+	paused, err := repo.ChangeCampaignStatus(ctx, campaign.ID, campaign.UserID, adminID, campaign.Version, models.StatusPaused, "reported by donors")
+	if err != nil {
+		t.Fatalf("ChangeCampaignStatus: %v", err)
+	}
+	if change := expectLastChange(t, repo, campaign.ID, adminID, models.StatusActive, models.StatusPaused); change.Reason != "reported by donors" {
+		t.Errorf("reason = %q, want the one given by the admin", change.Reason)
+	}
+
+	resumed, err := repo.UpdateCampaignByID(ctx, campaign.ID, campaign.UserID, adminID, models.CampaignDB{Status: models.StatusActive, Version: paused.Version}, []string{"status"})
+	if err != nil {
+		t.Fatalf("UpdateCampaignByID: %v", err)
+	}
+	expectLastChange(t, repo, campaign.ID, adminID, models.StatusPaused, models.StatusActive)
+
+	if err := repo.DeleteCampaignByID(ctx, campaign.ID, campaign.UserID, adminID, resumed.Version); err != nil {
+		t.Fatalf("DeleteCampaignByID: %v", err)
+	}
+	expectLastChange(t, repo, campaign.ID, adminID, models.StatusActive, models.StatusCancelled)
+
+	// The owner is still checked, acting does not make the admin the owner
+	other := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+	_, err = repo.ChangeCampaignStatus(ctx, other.ID, adminID, adminID, other.Version, models.StatusPaused, "")
+	expectKind(t, err, repository.ErrPermissionDenied)
+}
+
+func testReview(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	const moderatorID = 99
+
+	draft := mustCreate(t, repo, newCampaign(1, models.StatusDraft))
+	_, err := repo.ReviewCampaign(ctx, draft.ID, moderatorID, draft.Version, models.StatusActive, "")
+	expectKind(t, err, repository.ErrInvalidTransition)
+
+	submitted, err := repo.ChangeCampaignStatus(ctx, draft.ID, draft.UserID, draft.UserID, draft.Version, models.StatusPendingReview, "ready")
+	if err != nil {
+		t.Fatalf("ChangeCampaignStatus to pending_review: %v", err)
+	}
+	_, err = repo.ReviewCampaign(ctx, submitted.ID, moderatorID, submitted.Version, models.StatusPaused, "")
+	expectKind(t, err, repository.ErrInvalidTransition)
+
+	rejected, err := repo.ReviewCampaign(ctx, submitted.ID, moderatorID, submitted.Version, models.StatusRejected, "missing photos")
+	if err != nil {
+		t.Fatalf("ReviewCampaign to rejected: %v", err)
+	}
+	if rejected.Status != models.StatusRejected || rejected.RejectionReason != "missing photos" {
+		t.Errorf("status/rejection reason = %q/%q, want rejected/missing photos", rejected.Status, rejected.RejectionReason)
+	}
+
+	resubmitted, err := repo.ChangeCampaignStatus(ctx, rejected.ID, rejected.UserID, rejected.UserID, rejected.Version, models.StatusPendingReview, "")
+	if err != nil {
+		t.Fatalf("ChangeCampaignStatus to pending_review again: %v", err)
+	}
+	approved, err := repo.ReviewCampaign(ctx, resubmitted.ID, moderatorID, resubmitted.Version, models.StatusActive, "")
+	if err != nil {
+		t.Fatalf("ReviewCampaign to active: %v", err)
+	}
+	if approved.Status != models.StatusActive || approved.RejectionReason != "" {
+		t.Errorf("status/rejection reason = %q/%q, want active/empty", approved.Status, approved.RejectionReason)
+	}
+}
+
+func testForceCancel(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	const adminID = 42
+
+	// Admins can cancel from statuses users cannot, like completed
+	completed := mustCreate(t, repo, newCampaign(1, models.StatusCompleted))
+	cancelled, err := repo.ForceCancelCampaign(ctx, completed.ID, adminID, "fraud")
+	if err != nil {
+		t.Fatalf("ForceCancelCampaign: %v", err)
+	}
+	if cancelled.Status != models.StatusCancelled || cancelled.Version != completed.Version+1 {
+		t.Errorf("status/version = %q/%d, want cancelled/%d", cancelled.Status, cancelled.Version, completed.Version+1)
+	}
+
+	_, err = repo.ForceCancelCampaign(ctx, completed.ID, adminID, "again")
+	expectKind(t, err, repository.ErrInvalidTransition)
+	_, err = repo.ForceCancelCampaign(ctx, uuid.NewString(), adminID, "")
+	expectKind(t, err, repository.ErrNotFound)
+}
+
+func testUserFiltering(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	draft := mustCreate(t, repo, newCampaign(1, models.StatusDraft))
+	active := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+	other := mustCreate(t, repo, newCampaign(2, models.StatusActive))
+
+	// Owners see their drafts
+	owned, err := repo.GetCampaignsByUserID(ctx, 1, repository.CampaignListOptions{})
+	if err != nil {
+		t.Fatalf("GetCampaignsByUserID: %v", err)
+	}
+	expectIDs(t, "GetCampaignsByUserID", owned.Campaigns, draft.ID, active.ID)
+	if owned.TotalCount != 2 {
+		t.Errorf("GetCampaignsByUserID total = %d, want 2", owned.TotalCount)
+	}
+
+	drafts, err := repo.GetCampaignsByUserID(ctx, 1, repository.CampaignListOptions{Statuses: []string{models.StatusDraft}})
+	if err != nil {
+		t.Fatalf("GetCampaignsByUserID of drafts: %v", err)
+	}
+	expectIDs(t, "GetCampaignsByUserID of drafts", drafts.Campaigns, draft.ID)
+	if drafts.TotalCount != 1 {
+		t.Errorf("GetCampaignsByUserID of drafts total = %d, want 1", drafts.TotalCount)
+	}
+
+	none, err := repo.GetCampaignsByUserID(ctx, 3, repository.CampaignListOptions{})
+	if err != nil {
+		t.Fatalf("GetCampaignsByUserID of a user without campaigns: %v", err)
+	}
+	expectIDs(t, "GetCampaignsByUserID of a user without campaigns", none.Campaigns)
+
+	// Everybody else only sees public campaigns
+	listed, err := repo.ListCampaigns(ctx, repository.CampaignListOptions{})
+	if err != nil {
+		t.Fatalf("ListCampaigns: %v", err)
+	}
+	expectIDs(t, "ListCampaigns", listed.Campaigns, active.ID, other.ID)
+}
+
+func testListFilters(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	small := newCampaign(1, models.StatusActive)
+	small.TargetAmount = 100_000
+	small.Category = "education"
+	small = mustCreate(t, repo, small)
+
+	large := newCampaign(1, models.StatusActive)
+	large.TargetAmount = 50_000_000
+	large.Deadline = time.Now().Add(90 * 24 * time.Hour)
+	large = mustCreate(t, repo, large)
+
+	paused := mustCreate(t, repo, newCampaign(2, models.StatusPaused))
+
+	minTarget := int64(1_000_000)
+	deadlineFrom := time.Now().Add(60 * 24 * time.Hour)
+	filters := []struct {
+		name string
+		opts repository.CampaignListOptions
+		want []string
+	}{
+		{"statuses", repository.CampaignListOptions{Statuses: []string{models.StatusPaused}}, []string{paused.ID}},
+		{"categories", repository.CampaignListOptions{Categories: []string{"education"}}, []string{small.ID}},
+		{"min target", repository.CampaignListOptions{MinTarget: &minTarget}, []string{large.ID, paused.ID}},
+		{"deadline from", repository.CampaignListOptions{DeadlineFrom: &deadlineFrom}, []string{large.ID}},
+		{"currency", repository.CampaignListOptions{Currency: "USD"}, nil},
+	}
+	for _, val := range filters {
+		page, err := repo.ListCampaigns(ctx, val.opts)
+		if err != nil {
+			t.Errorf("ListCampaigns by %v: %v", val.name, err)
+			continue
+		}
+		expectIDs(t, "ListCampaigns by "+val.name, page.Campaigns, val.want...)
+	}
+}
+
+func testPagination(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	var ids []string
+	for i := 0; i < 5; i++ {
+		campaign := newCampaign(1, models.StatusActive)
+		campaign.Deadline = time.Now().Add(time.Duration(30+i) * 24 * time.Hour)
+		campaign.CollectedAmount = int64(i%2) * 1_000
+		ids = append(ids, mustCreate(t, repo, campaign).ID)
+	}
+
+	orders := []repository.CampaignListOptions{
+		{},
+		{Descending: true},
+		{SortBy: "deadline"},
+		{SortBy: "deadline", Descending: true},
+		{SortBy: "collected_amount"},
+		{SortBy: "percent_funded", Descending: true},
+	}
+	for _, opts := range orders {
+		name := fmt.Sprintf("sort by %q descending %v", opts.SortBy, opts.Descending)
+		opts.PageSize = 2
+
+		var seen []models.CampaignDB
+		var pages int
+		for {
+			page, err := repo.GetCampaignsByUserID(ctx, 1, opts)
+			if err != nil {
+				t.Fatalf("%v: GetCampaignsByUserID: %v", name, err)
+			}
+			if page.TotalCount != 5 {
+				t.Errorf("%v: total = %d on every page, want 5", name, page.TotalCount)
+			}
+			if len(page.Campaigns) > opts.PageSize {
+				t.Fatalf("%v: page has %d campaigns, want at most %d", name, len(page.Campaigns), opts.PageSize)
+			}
+			seen = append(seen, page.Campaigns...)
+			pages++
+			if page.NextPageToken == "" || pages > 5 {
+				break
+			}
+			opts.PageToken = page.NextPageToken
+		}
+
+		if pages != 3 {
+			t.Errorf("%v: got %d pages of 2, want 3", name, pages)
+		}
+		expectIDs(t, name, seen, ids...)
+		for i := 1; i < len(seen); i++ {
+			if !sortedPair(seen[i-1], seen[i], opts) {
+				t.Errorf("%v: %v is listed before %v", name, seen[i-1].ID, seen[i].ID)
+			}
+		}
+	}
+}
+
+func testPageTokens(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		mustCreate(t, repo, newCampaign(1, models.StatusActive))
+	}
+
+	_, err := repo.ListCampaigns(ctx, repository.CampaignListOptions{PageToken: "not a token"})
+	expectKind(t, err, repository.ErrInvalidArgument)
+	_, err = repo.ListCampaigns(ctx, repository.CampaignListOptions{SortBy: "title"})
+	expectKind(t, err, repository.ErrInvalidArgument)
+
+	// A token only continues the order it was issued for
+	page, err := repo.ListCampaigns(ctx, repository.CampaignListOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListCampaigns: %v", err)
+	}
+	if page.NextPageToken == "" {
+		t.Fatal("ListCampaigns returned no next page token")
+	}
+	_, err = repo.ListCampaigns(ctx, repository.CampaignListOptions{PageSize: 1, Descending: true, PageToken: page.NextPageToken})
+	expectKind(t, err, repository.ErrInvalidArgument)
+	_, err = repo.ListCampaigns(ctx, repository.CampaignListOptions{PageSize: 1, SortBy: "deadline", PageToken: page.NextPageToken})
+	expectKind(t, err, repository.ErrInvalidArgument)
+}
+
+// Amounts above 2^53 minor units cannot be told apart as float64, page tokens must keep them exact
+func testLargeAmountPages(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	var ids []string
+	for i := 0; i < 4; i++ {
+		campaign := newCampaign(1, models.StatusActive)
+		campaign.TargetAmount = 1 << 62
+		campaign.CollectedAmount = 1<<53 + int64(3-i)
+		ids = append(ids, mustCreate(t, repo, campaign).ID)
+	}
+
+	opts := repository.CampaignListOptions{SortBy: "collected_amount", PageSize: 1}
+	var seen []models.CampaignDB
+	for i := 0; i < 4; i++ {
+		page, err := repo.ListCampaigns(ctx, opts)
+		if err != nil {
+			t.Fatalf("ListCampaigns: %v", err)
+		}
+		seen = append(seen, page.Campaigns...)
+		if page.NextPageToken == "" {
+			break
+		}
+		opts.PageToken = page.NextPageToken
+	}
+	expectIDs(t, "ListCampaigns by collected amount", seen, ids...)
+	for i := 1; i < len(seen); i++ {
+		if seen[i-1].CollectedAmount >= seen[i].CollectedAmount {
+			t.Errorf("collected amount %d is listed before %d", seen[i-1].CollectedAmount, seen[i].CollectedAmount)
+		}
+	}
+}
+
+// Postgres ranks with tsvector, the other backends match with LIKE or $regex and rank in Go. The
+// ranks differ between backends, the matches, their order and the highlights must not.
+func testSearch(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	withText := func(status string, title string, description string) models.CampaignDB {
+		campaign := newCampaign(1, status)
+		campaign.Title, campaign.Description = title, description
+		return campaign
+	}
+	inTitle := mustCreate(t, repo, withText(models.StatusActive, "Solar lamps for Alor", "Light for every home on the island"))
+	inDescription := mustCreate(t, repo, withText(models.StatusActive, "Light for every home on the island", "Solar lamps for Alor"))
+	health := withText(models.StatusPaused, "Solar fridges for the Alor clinic", "Vaccines kept cold")
+	health.Category = "healthcare"
+	health = mustCreate(t, repo, health)
+	draft := mustCreate(t, repo, withText(models.StatusDraft, "Solar lamps for Alor", "Not published yet"))
+	deleted := mustCreate(t, repo, withText(models.StatusActive, "Solar lamps for Alor", "Deleted by its owner"))
+	if err := repo.DeleteCampaignByID(ctx, deleted.ID, deleted.UserID, deleted.UserID, deleted.Version); err != nil {
+		t.Fatalf("DeleteCampaignByID: %v", err)
+	}
+	mustCreate(t, repo, withText(models.StatusActive, "Solar panels for Flores", "Power for a school"))
+
+	searches := []struct {
+		name string
+		opts repository.CampaignSearchOptions
+		want []string
+	}{
+		{"every term has to match", repository.CampaignSearchOptions{Query: "solar alor"}, []string{inTitle.ID, inDescription.ID, health.ID}},
+		{"terms match word prefixes", repository.CampaignSearchOptions{Query: "SOL ALO"}, []string{inTitle.ID, inDescription.ID, health.ID}},
+		{"punctuation is ignored", repository.CampaignSearchOptions{Query: "lamps, alor!"}, []string{inTitle.ID, inDescription.ID}},
+		{"statuses", repository.CampaignSearchOptions{Query: "alor", Statuses: []string{models.StatusDraft}}, []string{draft.ID}},
+		{"categories", repository.CampaignSearchOptions{Query: "alor", Categories: []string{"healthcare"}}, []string{health.ID}},
+		{"deleted campaigns are never found", repository.CampaignSearchOptions{Query: "deleted owner", Statuses: []string{models.StatusCancelled}}, nil},
+		{"no match", repository.CampaignSearchOptions{Query: "solar sumba"}, nil},
+	}
+	for _, val := range searches {
+		page, err := repo.SearchCampaigns(ctx, val.opts)
+		if err != nil {
+			t.Errorf("SearchCampaigns %v: %v", val.name, err)
+			continue
+		}
+		var found []models.CampaignDB
+		for _, result := range page.Results {
+			found = append(found, result.Campaign)
+		}
+		expectIDs(t, "SearchCampaigns "+val.name, found, val.want...)
+	}
+
+	// Title matches rank above description matches and every match is highlighted
+	page, err := repo.SearchCampaigns(ctx, repository.CampaignSearchOptions{Query: "lamps"})
+	if err != nil {
+		t.Fatalf("SearchCampaigns: %v", err)
+	}
+	if len(page.Results) != 2 || page.Results[0].Campaign.ID != inTitle.ID || page.Results[1].Campaign.ID != inDescription.ID {
+		t.Fatalf("SearchCampaigns of lamps returned %v, want the title match before the description match", page.Results)
+	}
+	if page.Results[0].Rank <= page.Results[1].Rank {
+		t.Errorf("title match rank %v is not above description match rank %v", page.Results[0].Rank, page.Results[1].Rank)
+	}
+	first, second := page.Results[0], page.Results[1]
+	if !strings.Contains(first.TitleHighlight, "<mark>lamps</mark>") || strings.Contains(first.DescriptionHighlight, "<mark>") {
+		t.Errorf("title match highlights = %q / %q", first.TitleHighlight, first.DescriptionHighlight)
+	}
+	if strings.Contains(second.TitleHighlight, "<mark>") || !strings.Contains(second.DescriptionHighlight, "<mark>lamps</mark>") {
+		t.Errorf("description match highlights = %q / %q", second.TitleHighlight, second.DescriptionHighlight)
+	}
+
+	for _, query := range []string{"", " ?! "} {
+		_, err := repo.SearchCampaigns(ctx, repository.CampaignSearchOptions{Query: query})
+		expectKind(t, err, repository.ErrInvalidArgument)
+	}
+	_, err = repo.SearchCampaigns(ctx, repository.CampaignSearchOptions{Query: "solar", PageToken: "not-a-token"})
+	expectKind(t, err, repository.ErrInvalidArgument)
+}
+
+func testSearchPages(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	var ids []string
+	for i := 0; i < 5; i++ {
+		campaign := newCampaign(1, models.StatusActive)
+		// Some campaigns rank alike, the id breaks the tie
+		campaign.Title = strings.Repeat("water ", 1+i%2) + "for Sumba"
+		ids = append(ids, mustCreate(t, repo, campaign).ID)
+	}
+
+	var found []models.CampaignDB
+	var lastRank float64
+	opts := repository.CampaignSearchOptions{Query: "water", PageSize: 2}
+	for pages := 0; ; pages++ {
+		if pages > len(ids) {
+			t.Fatalf("SearchCampaigns did not stop paging after %d pages", pages)
+		}
+		page, err := repo.SearchCampaigns(ctx, opts)
+		if err != nil {
+			t.Fatalf("SearchCampaigns page %d: %v", pages+1, err)
+		}
+		for _, val := range page.Results {
+			if len(found) > 0 && val.Rank > lastRank {
+				t.Errorf("rank %v of %v follows the lower rank %v", val.Rank, val.Campaign.ID, lastRank)
+			}
+			lastRank = val.Rank
+			found = append(found, val.Campaign)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		opts.PageToken = page.NextPageToken
+	}
+	expectIDs(t, "SearchCampaigns across pages", found, ids...)
+}
+
+func testContributions(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := newCampaign(1, models.StatusActive)
+	campaign.AutoCompleteOnTarget = true
+	campaign = mustCreate(t, repo, campaign)
+
+	contribution := newContribution(campaign, 400_000)
+	credited, err := repo.RecordContribution(ctx, contribution)
+	if err != nil {
+		t.Fatalf("RecordContribution: %v", err)
+	}
+	if credited.CollectedAmount != 400_000 || credited.Version != campaign.Version {
+		t.Errorf("collected/version = %d/%d, want 400000/%d as donations keep the etag", credited.CollectedAmount, credited.Version, campaign.Version)
+	}
+
+	_, err = repo.RecordContribution(ctx, contribution)
+	expectKind(t, err, repository.ErrAlreadyExists)
+
+	belowMinimum := newContribution(campaign, campaign.MinDonation-1)
+	_, err = repo.RecordContribution(ctx, belowMinimum)
+	expectKind(t, err, repository.ErrInvalidArgument)
+
+	otherCurrency := newContribution(campaign, 400_000)
+	otherCurrency.Currency = "USD"
+	_, err = repo.RecordContribution(ctx, otherCurrency)
+	expectKind(t, err, repository.ErrInvalidArgument)
+
+	_, err = repo.RecordContribution(ctx, models.CampaignContributionDB{ID: uuid.NewString(), CampaignID: uuid.NewString(), Currency: "IDR", Amount: 10_000, Source: "donation"})
+	expectKind(t, err, repository.ErrNotFound)
+
+	// Reaching the target completes the campaign, which then takes no more donations
+	completed, err := repo.RecordContribution(ctx, newContribution(campaign, 600_000))
+	if err != nil {
+		t.Fatalf("RecordContribution reaching the target: %v", err)
+	}
+	if completed.Status != models.StatusCompleted || completed.CollectedAmount != 1_000_000 || completed.Version != campaign.Version+1 {
+		t.Errorf("status/collected/version = %q/%d/%d, want completed/1000000/%d", completed.Status, completed.CollectedAmount, completed.Version, campaign.Version+1)
+	}
+	expectSystemChange(t, repo, campaign.ID, models.StatusActive, models.StatusCompleted)
+	_, err = repo.RecordContribution(ctx, newContribution(campaign, 10_000))
+	expectKind(t, err, repository.ErrPrecondition)
+
+	reconciliation, err := repo.ReconcileCampaign(ctx, campaign.ID, false)
+	if err != nil {
+		t.Fatalf("ReconcileCampaign: %v", err)
+	}
+	if reconciliation.LedgerAmount != 1_000_000 || reconciliation.Drift != 0 {
+		t.Errorf("ledger/drift = %d/%d, want 1000000/0", reconciliation.LedgerAmount, reconciliation.Drift)
+	}
+}
+
+func testReversals(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := newCampaign(1, models.StatusActive)
+	campaign.AutoCompleteOnTarget = true
+	campaign = mustCreate(t, repo, campaign)
+
+	contribution := newContribution(campaign, campaign.TargetAmount)
+	completed, err := repo.RecordContribution(ctx, contribution)
+	if err != nil {
+		t.Fatalf("RecordContribution: %v", err)
+	}
+
+	// Falling under the target re-opens the campaign
+	reversal := newReversal(contribution, 300_000)
+	reopened, err := repo.ReverseContribution(ctx, reversal)
+	if err != nil {
+		t.Fatalf("ReverseContribution: %v", err)
+	}
+	if reopened.Status != models.StatusActive || reopened.CollectedAmount != campaign.TargetAmount-300_000 || reopened.Version != completed.Version+1 {
+		t.Errorf("status/collected/version = %q/%d/%d, want active/%d/%d", reopened.Status, reopened.CollectedAmount, reopened.Version, campaign.TargetAmount-300_000, completed.Version+1)
+	}
+	expectSystemChange(t, repo, campaign.ID, models.StatusCompleted, models.StatusActive)
+
+	// A contribution cannot be reversed for more than what is left of it
+	_, err = repo.ReverseContribution(ctx, newReversal(contribution, campaign.TargetAmount))
+	expectKind(t, err, repository.ErrPrecondition)
+
+	missing := newReversal(contribution, 1_000)
+	missing.ContributionID = uuid.NewString()
+	_, err = repo.ReverseContribution(ctx, missing)
+	expectKind(t, err, repository.ErrNotFound)
+
+	reconciliation, err := repo.ReconcileCampaign(ctx, campaign.ID, true)
+	if err != nil {
+		t.Fatalf("ReconcileCampaign: %v", err)
+	}
+	if reconciliation.LedgerAmount != reopened.CollectedAmount || reconciliation.Drift != 0 || reconciliation.Fixed {
+		t.Errorf("ledger/drift/fixed = %d/%d/%v, want %d/0/false", reconciliation.LedgerAmount, reconciliation.Drift, reconciliation.Fixed, reopened.CollectedAmount)
+	}
+}
+
+func testReconcile(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+
+	// A campaign that matches its ledger is left alone
+	balanced := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+	contribution := newContribution(balanced, 50_000)
+	if _, err := repo.RecordContribution(ctx, contribution); err != nil {
+		t.Fatalf("RecordContribution: %v", err)
+	}
+	if _, err := repo.ReverseContribution(ctx, newReversal(contribution, 20_000)); err != nil {
+		t.Fatalf("ReverseContribution: %v", err)
+	}
+	reconciliation, err := repo.ReconcileCampaign(ctx, balanced.ID, true)
+	if err != nil {
+		t.Fatalf("ReconcileCampaign: %v", err)
+	}
+	if reconciliation.LedgerAmount != 30_000 || reconciliation.Drift != 0 || reconciliation.Fixed {
+		t.Errorf("balanced campaign reconciled to ledger %d, drift %d, fixed %v, want 30000, 0, false", reconciliation.LedgerAmount, reconciliation.Drift, reconciliation.Fixed)
+	}
+
+	// An amount the ledger does not know of is reported, and only reset when asked to
+	drifted := newCampaign(1, models.StatusActive)
+	drifted.CollectedAmount = 70_000
+	drifted = mustCreate(t, repo, drifted)
+	if _, err := repo.RecordContribution(ctx, newContribution(drifted, 50_000)); err != nil {
+		t.Fatalf("RecordContribution: %v", err)
+	}
+	reconciliation, err = repo.ReconcileCampaign(ctx, drifted.ID, false)
+	if err != nil {
+		t.Fatalf("ReconcileCampaign: %v", err)
+	}
+	if reconciliation.LedgerAmount != 50_000 || reconciliation.Drift != 70_000 || reconciliation.Fixed {
+		t.Errorf("drifted campaign reconciled to ledger %d, drift %d, fixed %v, want 50000, 70000, false", reconciliation.LedgerAmount, reconciliation.Drift, reconciliation.Fixed)
+	}
+	if got := mustGet(t, repo, drifted.ID); got.CollectedAmount != 120_000 {
+		t.Errorf("reconciling without fix changed the collected amount to %d", got.CollectedAmount)
+	}
+
+	reconciliation, err = repo.ReconcileCampaign(ctx, drifted.ID, true)
+	if err != nil {
+		t.Fatalf("ReconcileCampaign with fix: %v", err)
+	}
+	if !reconciliation.Fixed || reconciliation.Drift != 70_000 || reconciliation.Campaign.CollectedAmount != 50_000 {
+		t.Errorf("fixed reconciliation = drift %d, fixed %v, collected %d, want 70000, true, 50000", reconciliation.Drift, reconciliation.Fixed, reconciliation.Campaign.CollectedAmount)
+	}
+	if got := mustGet(t, repo, drifted.ID); got.CollectedAmount != 50_000 {
+		t.Errorf("stored collected amount = %d after the fix, want 50000", got.CollectedAmount)
+	}
+	if again, err := repo.ReconcileCampaign(ctx, drifted.ID, true); err != nil || again.Drift != 0 || again.Fixed {
+		t.Errorf("second ReconcileCampaign = drift %d, fixed %v, %v, want 0, false, nil", again.Drift, again.Fixed, err)
+	}
+
+	_, err = repo.ReconcileCampaign(ctx, uuid.NewString(), false)
+	expectKind(t, err, repository.ErrNotFound)
+}
+
+func testCompleteDueCampaigns(t *testing.T, repo repository.CampaignRepository) {
+	ctx := context.Background()
+	expired := newCampaign(1, models.StatusActive)
+	expired.Deadline = time.Now().Add(-time.Hour)
+	expired = mustCreate(t, repo, expired)
+
+	running := mustCreate(t, repo, newCampaign(1, models.StatusActive))
+
+	// Deadlines are compared to the moment, not the day
+	dueSoon := newCampaign(1, models.StatusActive)
+	dueSoon.Deadline = time.Now().Add(time.Minute)
+	dueSoon = mustCreate(t, repo, dueSoon)
+
+	expiredPaused := newCampaign(1, models.StatusPaused)
+	expiredPaused.Deadline = time.Now().Add(-time.Hour)
+	expiredPaused = mustCreate(t, repo, expiredPaused)
+
+	// Deleted campaigns are left alone even when they are past their deadline
+	expiredDeleted := newCampaign(1, models.StatusActive)
+	expiredDeleted.Deadline = time.Now().Add(-time.Hour)
+	expiredDeleted = mustCreate(t, repo, expiredDeleted)
+	if err := repo.DeleteCampaignByID(ctx, expiredDeleted.ID, expiredDeleted.UserID, expiredDeleted.UserID, expiredDeleted.Version); err != nil {
+		t.Fatalf("DeleteCampaignByID: %v", err)
+	}
+
+	completed, err := repo.CompleteDueCampaigns(ctx, time.Now())
+	if err != nil {
+		t.Fatalf("CompleteDueCampaigns: %v", err)
+	}
+	if completed != 1 {
+		t.Errorf("CompleteDueCampaigns completed %d campaigns, want 1", completed)
+	}
+
+	expectStatus := map[string]string{
+		expired.ID:       models.StatusCompleted,
+		running.ID:       models.StatusActive,
+		dueSoon.ID:       models.StatusActive,
+		expiredPaused.ID: models.StatusPaused,
+	}
+	for id, status := range expectStatus {
+		if got := mustGet(t, repo, id); got.Status != status {
+			t.Errorf("campaign %v status = %q, want %q", id, got.Status, status)
+		}
+	}
+	expectSystemChange(t, repo, expired.ID, models.StatusActive, models.StatusCompleted)
+
+	deleted, err := repo.ListCampaigns(ctx, repository.CampaignListOptions{IncludeDeleted: true, Statuses: []string{models.StatusCompleted}})
+	if err != nil {
+		t.Fatalf("ListCampaigns including deleted: %v", err)
+	}
+	expectIDs(t, "ListCampaigns of completed including deleted", deleted.Campaigns, expired.ID)
+
+	// Running it again finds nothing left to do
+	if completed, err := repo.CompleteDueCampaigns(ctx, time.Now()); err != nil || completed != 0 {
+		t.Errorf("second CompleteDueCampaigns = %d, %v, want 0, nil", completed, err)
+	}
+}
@@ -0,0 +1,177 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+)
+
+// CampaignUpdateRepositoryFactory returns an empty update repository for a single test, along with
+// the campaign repository of the same storage that holds the campaigns the updates are posted on
+type CampaignUpdateRepositoryFactory func(t *testing.T) (repository.CampaignUpdateRepository, repository.CampaignRepository)
+
+// RunCampaignUpdateRepository runs every conformance case against the repositories made by newRepo
+func RunCampaignUpdateRepository(t *testing.T, newRepo CampaignUpdateRepositoryFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, updates repository.CampaignUpdateRepository, campaigns repository.CampaignRepository)
+	}{
+		{"CreateAndGet", testUpdateCreateAndGet},
+		{"EditAndDelete", testUpdateEditAndDelete},
+		{"ListPages", testUpdateListPages},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			updates, campaigns := newRepo(t)
+			val.run(t, updates, campaigns)
+		})
+	}
+}
+
+// newUpdate returns a news post of the owner of campaign, created at the given time.
+// Times are whole seconds so every backend stores them exactly.
+func newUpdate(campaign models.CampaignDB, createdAt time.Time) models.CampaignUpdateDB {
+	return models.CampaignUpdateDB{
+		ID:         uuid.NewString(),
+		CampaignID: campaign.ID,
+		UserID:     campaign.UserID,
+		Title:      "Drilling started",
+		Content:    "The first well is 20 metres deep",
+		CreatedAt:  createdAt.UTC().Truncate(time.Second),
+	}
+}
+
+func mustCreateUpdate(t *testing.T, updates repository.CampaignUpdateRepository, update models.CampaignUpdateDB) models.CampaignUpdateDB {
+	t.Helper()
+	created, err := updates.CreateCampaignUpdate(context.Background(), update)
+	if err != nil {
+		t.Fatalf("CreateCampaignUpdate: %v", err)
+	}
+	return created
+}
+
+func testUpdateCreateAndGet(t *testing.T, updates repository.CampaignUpdateRepository, campaigns repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := mustCreate(t, campaigns, newCampaign(1, models.StatusActive))
+	created := mustCreateUpdate(t, updates, newUpdate(campaign, time.Now()))
+
+	got, err := updates.GetCampaignUpdateByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetCampaignUpdateByID: %v", err)
+	}
+	if got.CampaignID != campaign.ID || got.UserID != campaign.UserID || got.Title != created.Title || got.Content != created.Content {
+		t.Errorf("GetCampaignUpdateByID = %+v, want %+v", got, created)
+	}
+	if !got.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("created at = %v, want %v", got.CreatedAt, created.CreatedAt)
+	}
+
+	_, err = updates.CreateCampaignUpdate(ctx, created)
+	expectKind(t, err, repository.ErrAlreadyExists)
+	_, err = updates.GetCampaignUpdateByID(ctx, uuid.NewString())
+	expectKind(t, err, repository.ErrNotFound)
+}
+
+func testUpdateEditAndDelete(t *testing.T, updates repository.CampaignUpdateRepository, campaigns repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := mustCreate(t, campaigns, newCampaign(1, models.StatusActive))
+	created := mustCreateUpdate(t, updates, newUpdate(campaign, time.Now()))
+
+	edited, err := updates.EditCampaignUpdate(ctx, created.ID, models.CampaignUpdateDB{Title: "Water found", Content: "At 35 metres"})
+	if err != nil {
+		t.Fatalf("EditCampaignUpdate: %v", err)
+	}
+	if edited.Title != "Water found" || edited.Content != "At 35 metres" || edited.CampaignID != campaign.ID {
+		t.Errorf("EditCampaignUpdate = %+v", edited)
+	}
+
+	// Title and content are written together, even when one of them is empty
+	edited, err = updates.EditCampaignUpdate(ctx, created.ID, models.CampaignUpdateDB{Title: "Water found"})
+	if err != nil {
+		t.Fatalf("EditCampaignUpdate with empty content: %v", err)
+	}
+	if edited.Content != "" {
+		t.Errorf("content = %q, want it cleared", edited.Content)
+	}
+	_, err = updates.EditCampaignUpdate(ctx, uuid.NewString(), models.CampaignUpdateDB{Title: "Water found"})
+	expectKind(t, err, repository.ErrNotFound)
+
+	if err := updates.DeleteCampaignUpdate(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteCampaignUpdate: %v", err)
+	}
+	_, err = updates.GetCampaignUpdateByID(ctx, created.ID)
+	expectKind(t, err, repository.ErrNotFound)
+	_, err = updates.EditCampaignUpdate(ctx, created.ID, models.CampaignUpdateDB{Title: "Water found"})
+	expectKind(t, err, repository.ErrNotFound)
+	expectKind(t, updates.DeleteCampaignUpdate(ctx, created.ID), repository.ErrNotFound)
+	expectKind(t, updates.DeleteCampaignUpdate(ctx, uuid.NewString()), repository.ErrNotFound)
+}
+
+func testUpdateListPages(t *testing.T, updates repository.CampaignUpdateRepository, campaigns repository.CampaignRepository) {
+	ctx := context.Background()
+	campaign := mustCreate(t, campaigns, newCampaign(1, models.StatusActive))
+	start := time.Now().Add(-time.Hour)
+
+	// Newest first, updates posted in the same second are ordered by id
+	var want []string
+	for i := 0; i < 5; i++ {
+		created := mustCreateUpdate(t, updates, newUpdate(campaign, start.Add(time.Duration(i/2)*time.Minute)))
+		want = append(want, created.ID)
+	}
+	deleted := mustCreateUpdate(t, updates, newUpdate(campaign, start.Add(time.Hour)))
+	if err := updates.DeleteCampaignUpdate(ctx, deleted.ID); err != nil {
+		t.Fatalf("DeleteCampaignUpdate: %v", err)
+	}
+	other := mustCreate(t, campaigns, newCampaign(1, models.StatusActive))
+	mustCreateUpdate(t, updates, newUpdate(other, start))
+
+	var got []models.CampaignUpdateDB
+	pageToken := ""
+	for pages := 0; ; pages++ {
+		if pages > len(want) {
+			t.Fatalf("ListCampaignUpdates did not stop paging after %d pages", pages)
+		}
+		page, err := updates.ListCampaignUpdates(ctx, campaign.ID, 2, pageToken)
+		if err != nil {
+			t.Fatalf("ListCampaignUpdates page %d: %v", pages+1, err)
+		}
+		if len(page.Updates) > 2 {
+			t.Errorf("page %d has %d updates, want at most 2", pages+1, len(page.Updates))
+		}
+		got = append(got, page.Updates...)
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("ListCampaignUpdates returned %d updates, want %d", len(got), len(want))
+	}
+	for i := 1; i < len(got); i++ {
+		previous, current := got[i-1], got[i]
+		if current.CreatedAt.After(previous.CreatedAt) || (current.CreatedAt.Equal(previous.CreatedAt) && current.ID > previous.ID) {
+			t.Errorf("update %v listed after %v is newer", current.ID, previous.ID)
+		}
+	}
+	seen := map[string]bool{}
+	for _, val := range got {
+		if val.CampaignID != campaign.ID || seen[val.ID] {
+			t.Errorf("ListCampaignUpdates returned %v of campaign %v twice or for the wrong campaign", val.ID, val.CampaignID)
+		}
+		seen[val.ID] = true
+	}
+	for _, id := range want {
+		if !seen[id] {
+			t.Errorf("ListCampaignUpdates skipped update %v", id)
+		}
+	}
+
+	_, err := updates.ListCampaignUpdates(ctx, campaign.ID, 2, "not-a-token")
+	expectKind(t, err, repository.ErrInvalidArgument)
+}
@@ -0,0 +1,145 @@
+package repotest
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+)
+
+// newCampaign returns a campaign of userID that is complete enough to be published.
+// An empty status leaves it to the repository default.
+func newCampaign(userID int32, status string) models.CampaignDB {
+	return models.CampaignDB{
+		ID:           uuid.NewString(),
+		UserID:       userID,
+		Title:        "Clean water for Sumba",
+		Description:  "Wells for three villages in East Sumba",
+		Currency:     "IDR",
+		TargetAmount: 1_000_000,
+		Deadline:     time.Now().Add(30 * 24 * time.Hour),
+		Status:       status,
+		Category:     "community",
+		MinDonation:  10_000,
+	}
+}
+
+func newContribution(campaign models.CampaignDB, amount int64) models.CampaignContributionDB {
+	return models.CampaignContributionDB{
+		ID:         uuid.NewString(),
+		CampaignID: campaign.ID,
+		Currency:   campaign.Currency,
+		Amount:     amount,
+		Source:     "donation",
+	}
+}
+
+func newReversal(contribution models.CampaignContributionDB, amount int64) models.CampaignReversalDB {
+	return models.CampaignReversalDB{
+		ID:             uuid.NewString(),
+		CampaignID:     contribution.CampaignID,
+		ContributionID: contribution.ID,
+		Currency:       contribution.Currency,
+		Amount:         amount,
+		Reason:         "refund",
+	}
+}
+
+func mustCreate(t *testing.T, repo repository.CampaignRepository, campaign models.CampaignDB) models.CampaignDB {
+	t.Helper()
+	created, err := repo.CreateCampaign(context.Background(), campaign)
+	if err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+	return created
+}
+
+func mustGet(t *testing.T, repo repository.CampaignRepository, id string) models.CampaignDB {
+	t.Helper()
+	campaign, err := repo.GetCampaignByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetCampaignByID(%v): %v", id, err)
+	}
+	return campaign
+}
+
+// expectLastChange fails the test unless the latest status change of the campaign moved it from
+// one status to another on behalf of userID, and returns that change
+func expectLastChange(t *testing.T, repo repository.CampaignRepository, id string, userID int32, from string, to string) models.CampaignStatusChangeDB {
+	t.Helper()
+	history, err := repo.GetStatusHistory(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetStatusHistory(%v): %v", id, err)
+	}
+	if len(history) == 0 {
+		t.Errorf("campaign %v has no status history, want %v -> %v", id, from, to)
+		return models.CampaignStatusChangeDB{}
+	}
+	last := history[len(history)-1]
+	if last.FromStatus != from || last.ToStatus != to || last.UserID != userID {
+		t.Errorf("latest status change of %v = %v -> %v by %d, want %v -> %v by %d", id, last.FromStatus, last.ToStatus, last.UserID, from, to, userID)
+	}
+	return last
+}
+
+// expectSystemChange fails the test unless the latest status change of the campaign moved it from
+// one status to another on behalf of the system, giving a reason
+func expectSystemChange(t *testing.T, repo repository.CampaignRepository, id string, from string, to string) {
+	t.Helper()
+	if change := expectLastChange(t, repo, id, models.SystemUserID, from, to); change.Reason == "" {
+		t.Errorf("system status change of %v has no reason", id)
+	}
+}
+
+// expectKind fails the test unless err is a repository error of the given kind
+func expectKind(t *testing.T, err error, kind error) {
+	t.Helper()
+	if !errors.Is(err, kind) {
+		t.Errorf("error = %v, want %v", err, kind)
+	}
+}
+
+// expectIDs fails the test unless campaigns holds exactly the given ids, in any order and without duplicates
+func expectIDs(t *testing.T, what string, campaigns []models.CampaignDB, ids ...string) {
+	t.Helper()
+	var got []string
+	for _, val := range campaigns {
+		got = append(got, val.ID)
+	}
+	want := slices.Clone(ids)
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("%v returned campaigns %v, want %v", what, got, want)
+	}
+}
+
+// sortedPair reports whether a may be listed before b in the order of opts, ties are broken by id
+func sortedPair(a models.CampaignDB, b models.CampaignDB, opts repository.CampaignListOptions) bool {
+	var order int
+	switch opts.SortBy {
+	case "deadline":
+		order = a.Deadline.Compare(b.Deadline)
+	case "collected_amount":
+		order = cmp.Compare(a.CollectedAmount, b.CollectedAmount)
+	case "percent_funded":
+		order = cmp.Compare(float64(a.CollectedAmount)/float64(a.TargetAmount), float64(b.CollectedAmount)/float64(b.TargetAmount))
+	default:
+		order = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if order == 0 {
+		order = strings.Compare(a.ID, b.ID)
+	}
+	if opts.Descending {
+		order = -order
+	}
+	return order < 0
+}
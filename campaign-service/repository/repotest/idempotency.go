@@ -0,0 +1,126 @@
+package repotest
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+)
+
+// IdempotencyRepositoryFactory returns an empty repository for a single test, along with a function
+// that moves the last write of a key back by age so stale reservations can be tested
+type IdempotencyRepositoryFactory func(t *testing.T) (repository.IdempotencyRepository, func(key string, operation string, age time.Duration))
+
+// staleAge is older than any reservation is kept without a response
+const staleAge = time.Hour
+
+// RunIdempotencyRepository runs every conformance case against the repositories made by newRepo
+func RunIdempotencyRepository(t *testing.T, newRepo IdempotencyRepositoryFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, keys repository.IdempotencyRepository, age func(key string, operation string, age time.Duration))
+	}{
+		{"Reserve", testReserveKey},
+		{"Complete", testCompleteKey},
+		{"Release", testReleaseKey},
+		{"StaleReservations", testStaleReservations},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			keys, age := newRepo(t)
+			val.run(t, keys, age)
+		})
+	}
+}
+
+// reserve reserves key and fails the test unless owned is what the repository answered
+func reserve(t *testing.T, keys repository.IdempotencyRepository, key string, operation string, requestHash string, owned bool) []byte {
+	t.Helper()
+	record, got, err := keys.ReserveKey(context.Background(), key, operation, requestHash)
+	if err != nil {
+		t.Fatalf("ReserveKey(%v, %v): %v", key, operation, err)
+	}
+	if got != owned {
+		t.Errorf("ReserveKey(%v, %v) owned = %v, want %v", key, operation, got, owned)
+	}
+	if record.Key != key || record.Operation != operation {
+		t.Errorf("ReserveKey(%v, %v) returned the record of %v, %v", key, operation, record.Key, record.Operation)
+	}
+	return record.Response
+}
+
+func testReserveKey(t *testing.T, keys repository.IdempotencyRepository, age func(string, string, time.Duration)) {
+	reserve(t, keys, "key-1", "CreateCampaign", hash("a"), true)
+
+	// A second request waits for the first one, whatever its payload
+	if response := reserve(t, keys, "key-1", "CreateCampaign", hash("a"), false); response != nil {
+		t.Errorf("pending reservation has response %q", response)
+	}
+	reserve(t, keys, "key-1", "CreateCampaign", hash("b"), false)
+
+	// Keys are scoped to their operation
+	reserve(t, keys, "key-1", "RecordContribution", hash("a"), true)
+	reserve(t, keys, "key-2", "CreateCampaign", hash("a"), true)
+}
+
+func testCompleteKey(t *testing.T, keys repository.IdempotencyRepository, age func(string, string, time.Duration)) {
+	ctx := context.Background()
+	reserve(t, keys, "key-1", "CreateCampaign", hash("a"), true)
+	if err := keys.CompleteKey(ctx, "key-1", "CreateCampaign", []byte("response")); err != nil {
+		t.Fatalf("CompleteKey: %v", err)
+	}
+
+	if response := reserve(t, keys, "key-1", "CreateCampaign", hash("a"), false); string(response) != "response" {
+		t.Errorf("replayed response = %q, want %q", response, "response")
+	}
+	// Completed keys are never released
+	if err := keys.ReleaseKey(ctx, "key-1", "CreateCampaign"); err != nil {
+		t.Fatalf("ReleaseKey: %v", err)
+	}
+	if response := reserve(t, keys, "key-1", "CreateCampaign", hash("a"), false); string(response) != "response" {
+		t.Errorf("response after ReleaseKey = %q, want %q", response, "response")
+	}
+}
+
+func testReleaseKey(t *testing.T, keys repository.IdempotencyRepository, age func(string, string, time.Duration)) {
+	ctx := context.Background()
+	reserve(t, keys, "key-1", "CreateCampaign", hash("a"), true)
+	if err := keys.ReleaseKey(ctx, "key-1", "CreateCampaign"); err != nil {
+		t.Fatalf("ReleaseKey: %v", err)
+	}
+	// A failed request can be retried, also with another payload
+	reserve(t, keys, "key-1", "CreateCampaign", hash("b"), true)
+
+	if err := keys.ReleaseKey(ctx, "missing", "CreateCampaign"); err != nil {
+		t.Errorf("ReleaseKey of a missing key: %v", err)
+	}
+}
+
+// Reservations of crashed requests are taken over by a retry of the same request only. Postgres
+// does it with ON CONFLICT DO UPDATE, Mongo with InsertOne and a conditional FindOneAndUpdate.
+func testStaleReservations(t *testing.T, keys repository.IdempotencyRepository, age func(string, string, time.Duration)) {
+	ctx := context.Background()
+	reserve(t, keys, "crashed", "CreateCampaign", hash("a"), true)
+	age("crashed", "CreateCampaign", staleAge)
+	reserve(t, keys, "crashed", "CreateCampaign", hash("b"), false)
+	reserve(t, keys, "crashed", "CreateCampaign", hash("a"), true)
+	// The takeover renews the reservation
+	reserve(t, keys, "crashed", "CreateCampaign", hash("a"), false)
+
+	reserve(t, keys, "completed", "CreateCampaign", hash("a"), true)
+	if err := keys.CompleteKey(ctx, "completed", "CreateCampaign", []byte("response")); err != nil {
+		t.Fatalf("CompleteKey: %v", err)
+	}
+	age("completed", "CreateCampaign", staleAge)
+	if response := reserve(t, keys, "completed", "CreateCampaign", hash("a"), false); string(response) != "response" {
+		t.Errorf("replayed response = %q, want %q", response, "response")
+	}
+}
+
+// hash returns the request hash the service stores for payload
+func hash(payload string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(payload)))
+}
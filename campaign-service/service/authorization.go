@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/auth"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// requireRole returns the caller when it has one of the given roles
+func requireRole(ctx context.Context, roles ...string) (auth.Caller, error) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.Caller{}, status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+	if !caller.HasRole(roles...) {
+		return auth.Caller{}, status.Error(codes.PermissionDenied, "caller is not allowed to perform this operation")
+	}
+	return caller, nil
+}
+
+// ownerIDForCaller returns the owner id the repository has to match for the caller to act on a campaign,
+// along with the caller who is recorded as acting. Regular callers can only match their own id, admins
+// act on behalf of the actual owner but are still recorded themselves.
+func (s *campaignService) ownerIDForCaller(ctx context.Context, campaignID string) (int32, auth.Caller, error) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok {
+		return 0, auth.Caller{}, status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+	if !caller.HasRole(auth.RoleAdmin) {
+		return caller.UserID, caller, nil
+	}
+
+	ownedCampaign, err := s.campaignRepo.GetCampaignByID(ctx, campaignID)
+	if err != nil {
+		return 0, auth.Caller{}, err
+	}
+
+	return ownedCampaign.UserID, caller, nil
+}
+
+// canViewAllCampaignsOf reports whether the caller may see campaigns of userID in every status.
+// Drafts, campaigns under review and rejected campaigns are only shown to their owner, moderators and admins.
+func canViewAllCampaignsOf(ctx context.Context, userID int32) bool {
+	caller, ok := auth.FromContext(ctx)
+	return ok && (caller.UserID == userID || caller.HasRole(auth.RoleModerator, auth.RoleAdmin))
+}
+
+// visibleCampaign loads a campaign the caller may see. Campaigns that are not public are
+// reported as not found to everybody else, so their existence is not revealed.
+func (s *campaignService) visibleCampaign(ctx context.Context, id string) (models.CampaignDB, error) {
+	visible, err := s.campaignRepo.GetCampaignByID(ctx, id)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+	if !slices.Contains(models.PublicStatuses, visible.Status) && !canViewAllCampaignsOf(ctx, visible.UserID) {
+		return models.CampaignDB{}, withDetails(status.New(codes.NotFound, "Campaign not found"), &errdetails.ErrorInfo{Reason: "CAMPAIGN_NOT_FOUND", Domain: errorDomain})
+	}
+	return visible, nil
+}
@@ -3,13 +3,15 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/auth"
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/helper"
 	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
@@ -23,105 +25,128 @@ type CampaignService interface {
 	DeleteCampaignByID(ctx context.Context, req *campaign.DeleteCampaignByIDRequest) (*campaign.DeleteCampaignByIDResponse, error)
 	UpdateCampaignByID(ctx context.Context, req *campaign.UpdateCampaignByIDRequest) (*campaign.UpdateCampaignByIDResponse, error)
 	GetCampaignsByUserID(ctx context.Context, req *campaign.GetCampaignsByUserIDRequest) (*campaign.GetCampaignsByUserIDResponse, error)
+	ListCampaigns(ctx context.Context, req *campaign.ListCampaignsRequest) (*campaign.ListCampaignsResponse, error)
+	SearchCampaigns(ctx context.Context, req *campaign.SearchCampaignsRequest) (*campaign.SearchCampaignsResponse, error)
+	RecordContribution(ctx context.Context, req *campaign.RecordContributionRequest) (*campaign.RecordContributionResponse, error)
+	ReverseContribution(ctx context.Context, req *campaign.ReverseContributionRequest) (*campaign.ReverseContributionResponse, error)
+	ReconcileCampaign(ctx context.Context, req *campaign.ReconcileCampaignRequest) (*campaign.ReconcileCampaignResponse, error)
+	PauseCampaign(ctx context.Context, req *campaign.PauseCampaignRequest) (*campaign.PauseCampaignResponse, error)
+	ResumeCampaign(ctx context.Context, req *campaign.ResumeCampaignRequest) (*campaign.ResumeCampaignResponse, error)
+	CancelCampaign(ctx context.Context, req *campaign.CancelCampaignRequest) (*campaign.CancelCampaignResponse, error)
+	PublishCampaign(ctx context.Context, req *campaign.PublishCampaignRequest) (*campaign.PublishCampaignResponse, error)
+	SubmitForReview(ctx context.Context, req *campaign.SubmitForReviewRequest) (*campaign.SubmitForReviewResponse, error)
+	ApproveCampaign(ctx context.Context, req *campaign.ApproveCampaignRequest) (*campaign.ApproveCampaignResponse, error)
+	RejectCampaign(ctx context.Context, req *campaign.RejectCampaignRequest) (*campaign.RejectCampaignResponse, error)
+	ListPendingReviews(ctx context.Context, req *campaign.ListPendingReviewsRequest) (*campaign.ListPendingReviewsResponse, error)
+	ForceCancelCampaign(ctx context.Context, req *campaign.ForceCancelCampaignRequest) (*campaign.ForceCancelCampaignResponse, error)
+	ListAllCampaigns(ctx context.Context, req *campaign.ListAllCampaignsRequest) (*campaign.ListAllCampaignsResponse, error)
+	CreateCampaignUpdate(ctx context.Context, req *campaign.CreateCampaignUpdateRequest) (*campaign.CreateCampaignUpdateResponse, error)
+	ListCampaignUpdates(ctx context.Context, req *campaign.ListCampaignUpdatesRequest) (*campaign.ListCampaignUpdatesResponse, error)
+	EditCampaignUpdate(ctx context.Context, req *campaign.EditCampaignUpdateRequest) (*campaign.EditCampaignUpdateResponse, error)
+	DeleteCampaignUpdate(ctx context.Context, req *campaign.DeleteCampaignUpdateRequest) (*campaign.DeleteCampaignUpdateResponse, error)
 }
 
 // campaignService is the struct implementation of CampaignService
 type campaignService struct {
 	campaign.UnimplementedCampaignServiceServer
-	campaignRepo repository.CampaignRepository
+	campaignRepo       repository.CampaignRepository
+	campaignUpdateRepo repository.CampaignUpdateRepository
+	idempotencyRepo    repository.IdempotencyRepository
 }
 
-// NewCampaignService initializes and returns a new campaignService instance with a given Campaign repository
-func NewCampaignService(campaignRepo repository.CampaignRepository) *campaignService {
-	return &campaignService{campaignRepo: campaignRepo}
+// NewCampaignService initializes and returns a new campaignService instance with the given Campaign, CampaignUpdate and Idempotency repositories
+func NewCampaignService(campaignRepo repository.CampaignRepository, campaignUpdateRepo repository.CampaignUpdateRepository, idempotencyRepo repository.IdempotencyRepository) *campaignService {
+	return &campaignService{campaignRepo: campaignRepo, campaignUpdateRepo: campaignUpdateRepo, idempotencyRepo: idempotencyRepo}
 }
 
 func (s *campaignService) CreateCampaign(ctx context.Context, req *campaign.CreateCampaignRequest) (*campaign.CreateCampaignResponse, error) {
+	// The owner is whoever is authenticated, not the user_id in the request
+	caller, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+	if req.UserId != 0 && req.UserId != caller.UserID {
+		return nil, status.Error(codes.PermissionDenied, "campaigns can only be created for the authenticated user")
+	}
+
+	// Keys are scoped per user so two users cannot replay each other's campaigns
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+	if key != "" {
+		key = fmt.Sprintf("%d:%s", caller.UserID, key)
+	}
+	return runIdempotent(ctx, s.idempotencyRepo, key, "CreateCampaign", req, func() *campaign.CreateCampaignResponse {
+		return &campaign.CreateCampaignResponse{}
+	}, func() (*campaign.CreateCampaignResponse, error) {
+		return s.createCampaign(ctx, caller.UserID, req)
+	})
+}
+
+func (s *campaignService) createCampaign(ctx context.Context, userID int32, req *campaign.CreateCampaignRequest) (*campaign.CreateCampaignResponse, error) {
+	// Target and minimum donation have to be in the same currency
+	currency, err := helper.CommonCurrency(req.TargetAmount, req.MinDonation)
+	if err != nil {
+		return nil, err
+	}
+	if currency == "" {
+		currency = helper.DefaultCurrency
+	}
+
 	// Create a new uuid
 	uuid := uuid.New()
 
 	// Prepare a struct for campaign
 	campaignPayload := models.CampaignDB{
-		ID:           uuid.String(),
-		UserID:       req.UserId,
-		Title:        req.Title,
-		Description:  req.Description,
-		TargetAmount: req.TargetAmount,
-		Deadline:     req.Deadline.AsTime(),
-		Category:     helper.MapCategoryDB(int32(req.Category)),
-		MinDonation:  req.MinDonation,
+		ID:                   uuid.String(),
+		UserID:               userID,
+		Title:                req.Title,
+		Description:          req.Description,
+		Currency:             currency,
+		TargetAmount:         helper.MapMoneyUnits(req.TargetAmount),
+		Deadline:             req.Deadline.AsTime(),
+		Category:             helper.MapCategoryDB(int32(req.Category)),
+		MinDonation:          helper.MapMoneyUnits(req.MinDonation),
+		AutoCompleteOnTarget: req.AutoCompleteOnTarget,
 	}
 
 	// Insert campaign to database
-	campaignInterface, err := s.campaignRepo.CreateCampaign(campaignPayload)
+	createdCampaign, err := s.campaignRepo.CreateCampaign(ctx, campaignPayload)
 	if err != nil {
 		return nil, err
 	}
 
-	// Cast the campaignInterface type to models.CampaignDB
-	createdCampaign, ok := campaignInterface.(models.CampaignDB)
-	if !ok {
-		return nil, fmt.Errorf("failed to cast created campaign")
-	}
-
 	res := &campaign.CreateCampaignResponse{
-		CreatedCampaign: []*campaign.Campaign{
-			{
-				Id:              createdCampaign.ID,
-				UserId:          createdCampaign.UserID,
-				Title:           createdCampaign.Title,
-				Description:     createdCampaign.Description,
-				TargetAmount:    createdCampaign.TargetAmount,
-				CollectedAmount: createdCampaign.CollectedAmount,
-				Deadline:        timestamppb.New(createdCampaign.Deadline),
-				Status:          campaign.CampaignStatus(helper.MapStatusProto(createdCampaign.Status)),
-				Category:        campaign.CampaignCategory(helper.MapCateogryProto(createdCampaign.Category)),
-				MinDonation:     createdCampaign.MinDonation,
-				CreatedAt:       timestamppb.New(createdCampaign.CreatedAt),
-				UpdatedAt:       timestamppb.New(createdCampaign.UpdatedAt),
-			},
-		},
+		CreatedCampaign: []*campaign.Campaign{helper.MapCampaignProto(createdCampaign)},
 	}
 	return res, nil
 }
 
 func (s *campaignService) GetCampaignByID(ctx context.Context, req *campaign.GetCampaignByIDRequest) (*campaign.GetCampaignByIDResponse, error) {
-	// Get campaign by id
-	campaignInterface, err := s.campaignRepo.GetCampaignByID(req.Id)
+	// Get campaign by id, hiding campaigns that are not public from everybody but their owner and staff
+	getCampaign, err := s.visibleCampaign(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Cast the campaignInterface type to models.CampaignDB
-	getCampaign, ok := campaignInterface.(models.CampaignDB)
-	if !ok {
-		return nil, fmt.Errorf("failed to cast campaign")
-	}
-
 	res := &campaign.GetCampaignByIDResponse{
-		Campaign: []*campaign.Campaign{
-			{
-				Id:              getCampaign.ID,
-				UserId:          getCampaign.UserID,
-				Title:           getCampaign.Title,
-				Description:     getCampaign.Description,
-				TargetAmount:    getCampaign.TargetAmount,
-				CollectedAmount: getCampaign.CollectedAmount,
-				Deadline:        timestamppb.New(getCampaign.Deadline),
-				Status:          campaign.CampaignStatus(helper.MapStatusProto(getCampaign.Status)),
-				Category:        campaign.CampaignCategory(helper.MapCateogryProto(getCampaign.Category)),
-				MinDonation:     getCampaign.MinDonation,
-				CreatedAt:       timestamppb.New(getCampaign.CreatedAt),
-				UpdatedAt:       timestamppb.New(getCampaign.UpdatedAt),
-			},
-		},
+		Campaign: []*campaign.Campaign{helper.MapCampaignProto(getCampaign)},
 	}
 	return res, nil
 }
 
 func (s *campaignService) DeleteCampaignByID(ctx context.Context, req *campaign.DeleteCampaignByIDRequest) (*campaign.DeleteCampaignByIDResponse, error) {
+	version, err := helper.MapEtagVersion(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the owner or an admin can delete
+	ownerID, caller, err := s.ownerIDForCaller(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	// Delete campaign by id
-	err := s.campaignRepo.DeleteCampaignByID(req.Id)
+	err = s.campaignRepo.DeleteCampaignByID(ctx, req.Id, ownerID, caller.UserID, version)
 	if err != nil {
 		return nil, err
 	}
@@ -132,87 +157,468 @@ func (s *campaignService) DeleteCampaignByID(ctx context.Context, req *campaign.
 }
 
 func (s *campaignService) UpdateCampaignByID(ctx context.Context, req *campaign.UpdateCampaignByIDRequest) (*campaign.UpdateCampaignByIDResponse, error) {
-	// Prepare a struct for campaign
-	campaignPayload := models.CampaignDB{
-		Title:        req.Title,
-		Description:  req.Description,
-		TargetAmount: req.TargetAmount,
-		Deadline:     req.Deadline.AsTime(),
-		Status:       helper.MapStatusDB(int32(req.Status)),
-		Category:     helper.MapCategoryDB(int32(req.Category)),
-		MinDonation:  req.MinDonation,
+	// Prepare a struct for campaign holding only the fields in the update mask
+	campaignPayload, fields, err := campaignUpdateFields(req)
+	if err != nil {
+		return nil, err
+	}
+	campaignPayload.Version, err = helper.MapEtagVersion(req.Etag)
+	if err != nil {
+		return nil, err
 	}
 
 	// Check if user is trying to update status manually to completed
-	if campaignPayload.Status == "completed" {
+	if campaignPayload.Status == models.StatusCompleted {
 		return nil, status.Error(codes.PermissionDenied, "You cannot manually set status to COMPLETED")
 	}
+	// Cancelling needs a reason, so it has its own RPC
+	if campaignPayload.Status == models.StatusCancelled {
+		return nil, status.Error(codes.InvalidArgument, "Use CancelCampaign to cancel a campaign")
+	}
 
-	// Update campaign by id
-	campaignInterface, err := s.campaignRepo.UpdateCampaignByID(req.Id, req.UserId, campaignPayload)
+	// Only the owner or an admin can update
+	ownerID, caller, err := s.ownerIDForCaller(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Cast the campaignInterface type to models.CampaignDB
-	updatedCampaign, ok := campaignInterface.(models.CampaignDB)
-	if !ok {
-		return nil, fmt.Errorf("failed to cast updated campaign")
+	// Update campaign by id
+	updatedCampaign, err := s.campaignRepo.UpdateCampaignByID(ctx, req.Id, ownerID, caller.UserID, campaignPayload, fields)
+	if err != nil {
+		return nil, err
 	}
 
 	return &campaign.UpdateCampaignByIDResponse{
-		UpdatedCampaign: []*campaign.Campaign{
-			{
-				Id:              updatedCampaign.ID,
-				UserId:          updatedCampaign.UserID,
-				Title:           updatedCampaign.Title,
-				Description:     updatedCampaign.Description,
-				TargetAmount:    updatedCampaign.TargetAmount,
-				CollectedAmount: updatedCampaign.CollectedAmount,
-				Deadline:        timestamppb.New(updatedCampaign.Deadline),
-				Status:          campaign.CampaignStatus(helper.MapStatusProto(updatedCampaign.Status)),
-				Category:        campaign.CampaignCategory(helper.MapCateogryProto(updatedCampaign.Category)),
-				MinDonation:     updatedCampaign.MinDonation,
-				CreatedAt:       timestamppb.New(updatedCampaign.CreatedAt),
-				UpdatedAt:       timestamppb.New(updatedCampaign.UpdatedAt),
-			},
-		},
+		UpdatedCampaign: []*campaign.Campaign{helper.MapCampaignProto(updatedCampaign)},
 	}, nil
 }
 
 func (s *campaignService) GetCampaignsByUserID(ctx context.Context, req *campaign.GetCampaignsByUserIDRequest) (*campaign.GetCampaignsByUserIDResponse, error) {
+	// Newest campaigns first, optionally narrowed down by status
+	opts := repository.CampaignListOptions{
+		SortBy:     "created_at",
+		Descending: true,
+		PageSize:   int(req.PageSize),
+		PageToken:  req.PageToken,
+	}
+	if canViewAllCampaignsOf(ctx, req.UserId) {
+		for _, val := range req.Statuses {
+			opts.Statuses = append(opts.Statuses, helper.MapStatusDB(int32(val)))
+		}
+	} else {
+		// Everybody else only sees the public campaigns of the user
+		statuses, err := publicStatuses(req.Statuses)
+		if err != nil {
+			return nil, err
+		}
+		opts.Statuses = statuses
+		if len(opts.Statuses) == 0 {
+			opts.Statuses = models.PublicStatuses
+		}
+	}
+
 	// Get campaign by user id
-	campaignInterface, err := s.campaignRepo.GetCampaignsByUserID(req.UserId)
+	page, err := s.campaignRepo.GetCampaignsByUserID(ctx, req.UserId, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Cast the campaignInterface type to models.CampaignDB
-	getCampaign, ok := campaignInterface.([]models.CampaignDB)
-	if !ok {
-		return nil, fmt.Errorf("failed to cast campaign")
-	}
-
-	var campaignList []*campaign.Campaign
-	for _, val := range getCampaign {
-		campaignList = append(campaignList, &campaign.Campaign{
-			Id:              val.ID,
-			UserId:          val.UserID,
-			Title:           val.Title,
-			Description:     val.Description,
-			TargetAmount:    val.TargetAmount,
-			CollectedAmount: val.CollectedAmount,
-			Deadline:        timestamppb.New(val.Deadline),
-			Status:          campaign.CampaignStatus(helper.MapStatusProto(val.Status)),
-			Category:        campaign.CampaignCategory(helper.MapCateogryProto(val.Category)),
-			MinDonation:     val.MinDonation,
-			CreatedAt:       timestamppb.New(val.CreatedAt),
-			UpdatedAt:       timestamppb.New(val.UpdatedAt),
-		},
-		)
+	return &campaign.GetCampaignsByUserIDResponse{
+		Campaign:      helper.MapCampaignListProto(page.Campaigns),
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+func (s *campaignService) ListCampaigns(ctx context.Context, req *campaign.ListCampaignsRequest) (*campaign.ListCampaignsResponse, error) {
+	// Prepare list options from the request filters
+	opts := repository.CampaignListOptions{
+		Currency:   strings.ToUpper(req.CurrencyCode),
+		MinTarget:  req.MinTargetAmount,
+		MaxTarget:  req.MaxTargetAmount,
+		SortBy:     helper.MapSortFieldDB(int32(req.SortBy)),
+		Descending: req.Descending,
+		PageSize:   int(req.PageSize),
+		PageToken:  req.PageToken,
+	}
+	statuses, err := publicStatuses(req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	opts.Statuses = statuses
+	for _, val := range req.Categories {
+		opts.Categories = append(opts.Categories, helper.MapCategoryDB(int32(val)))
+	}
+	if req.DeadlineFrom != nil {
+		deadlineFrom := req.DeadlineFrom.AsTime()
+		opts.DeadlineFrom = &deadlineFrom
+	}
+	if req.DeadlineTo != nil {
+		deadlineTo := req.DeadlineTo.AsTime()
+		opts.DeadlineTo = &deadlineTo
 	}
 
-	return &campaign.GetCampaignsByUserIDResponse{
-		Campaign: campaignList,
+	// List campaigns
+	page, err := s.campaignRepo.ListCampaigns(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.ListCampaignsResponse{
+		Campaign:      helper.MapCampaignListProto(page.Campaigns),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *campaignService) SearchCampaigns(ctx context.Context, req *campaign.SearchCampaignsRequest) (*campaign.SearchCampaignsResponse, error) {
+	// Prepare search options from the request
+	opts := repository.CampaignSearchOptions{
+		Query:     req.Query,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}
+	statuses, err := publicStatuses(req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	opts.Statuses = statuses
+	for _, val := range req.Categories {
+		opts.Categories = append(opts.Categories, helper.MapCategoryDB(int32(val)))
+	}
+
+	// Search campaigns
+	page, err := s.campaignRepo.SearchCampaigns(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*campaign.SearchCampaignResult
+	for _, val := range page.Results {
+		results = append(results, &campaign.SearchCampaignResult{
+			Campaign:             helper.MapCampaignProto(val.Campaign),
+			Rank:                 val.Rank,
+			TitleHighlight:       val.TitleHighlight,
+			DescriptionHighlight: val.DescriptionHighlight,
+		})
+	}
+
+	return &campaign.SearchCampaignsResponse{
+		Result:        results,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *campaignService) RecordContribution(ctx context.Context, req *campaign.RecordContributionRequest) (*campaign.RecordContributionResponse, error) {
+	// Check the request before touching the campaign
+	if req.ContributionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "contribution_id is required")
+	}
+
+	// Webhook retries carry the same contribution id, so it is the default key
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+	if key == "" {
+		key = req.ContributionId
+	}
+	return runIdempotent(ctx, s.idempotencyRepo, key, "RecordContribution", req, func() *campaign.RecordContributionResponse {
+		return &campaign.RecordContributionResponse{}
+	}, func() (*campaign.RecordContributionResponse, error) {
+		return s.recordContribution(ctx, req)
+	})
+}
+
+func (s *campaignService) recordContribution(ctx context.Context, req *campaign.RecordContributionRequest) (*campaign.RecordContributionResponse, error) {
+	// Check the amount and its currency
+	if req.Amount == nil || req.Amount.Units <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be greater than zero")
+	}
+	currency, err := helper.CommonCurrency(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare a struct for the ledger entry
+	contributionPayload := models.CampaignContributionDB{
+		ID:         req.ContributionId,
+		CampaignID: req.CampaignId,
+		Currency:   currency,
+		Amount:     req.Amount.Units,
+		Source:     req.Source,
+	}
+	if contributionPayload.Source == "" {
+		contributionPayload.Source = "donation"
+	}
+
+	// Credit the contribution to the campaign
+	updatedCampaign, err := s.campaignRepo.RecordContribution(ctx, contributionPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.RecordContributionResponse{
+		CollectedAmount: helper.MapMoneyProto(updatedCampaign.CollectedAmount, updatedCampaign.Currency),
+		Campaign:        helper.MapCampaignProto(updatedCampaign),
+	}, nil
+}
+
+func (s *campaignService) ReverseContribution(ctx context.Context, req *campaign.ReverseContributionRequest) (*campaign.ReverseContributionResponse, error) {
+	// Check the request before touching the campaign
+	if req.ContributionId == "" || req.ReversalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "contribution_id and reversal_id are required")
+	}
+
+	// Payment retries carry the same reversal id, so it is the default key
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+	if key == "" {
+		key = req.ReversalId
+	}
+	return runIdempotent(ctx, s.idempotencyRepo, key, "ReverseContribution", req, func() *campaign.ReverseContributionResponse {
+		return &campaign.ReverseContributionResponse{}
+	}, func() (*campaign.ReverseContributionResponse, error) {
+		return s.reverseContribution(ctx, req)
+	})
+}
+
+func (s *campaignService) reverseContribution(ctx context.Context, req *campaign.ReverseContributionRequest) (*campaign.ReverseContributionResponse, error) {
+	// Check the amount and its currency
+	if req.Amount == nil || req.Amount.Units <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be greater than zero")
+	}
+	currency, err := helper.CommonCurrency(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare a struct for the ledger entry
+	reversalPayload := models.CampaignReversalDB{
+		ID:             req.ReversalId,
+		CampaignID:     req.CampaignId,
+		ContributionID: req.ContributionId,
+		Currency:       currency,
+		Amount:         req.Amount.Units,
+		Reason:         req.Reason,
+	}
+
+	// Take the amount back from the campaign
+	updatedCampaign, err := s.campaignRepo.ReverseContribution(ctx, reversalPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.ReverseContributionResponse{
+		CollectedAmount: helper.MapMoneyProto(updatedCampaign.CollectedAmount, updatedCampaign.Currency),
+		Campaign:        helper.MapCampaignProto(updatedCampaign),
+	}, nil
+}
+
+func (s *campaignService) ReconcileCampaign(ctx context.Context, req *campaign.ReconcileCampaignRequest) (*campaign.ReconcileCampaignResponse, error) {
+	// Recompute the collected amount from the ledger
+	reconciliation, err := s.campaignRepo.ReconcileCampaign(ctx, req.Id, req.Fix)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := reconciliation.Campaign.Currency
+	return &campaign.ReconcileCampaignResponse{
+		Campaign:     helper.MapCampaignProto(reconciliation.Campaign),
+		LedgerAmount: helper.MapMoneyProto(reconciliation.LedgerAmount, currency),
+		Drift:        helper.MapMoneyProto(reconciliation.Drift, currency),
+		Fixed:        reconciliation.Fixed,
+	}, nil
+}
+
+func (s *campaignService) PauseCampaign(ctx context.Context, req *campaign.PauseCampaignRequest) (*campaign.PauseCampaignResponse, error) {
+	updatedCampaign, err := s.changeCampaignStatus(ctx, req.Id, req.Etag, models.StatusPaused, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &campaign.PauseCampaignResponse{Campaign: helper.MapCampaignProto(updatedCampaign)}, nil
+}
+
+func (s *campaignService) ResumeCampaign(ctx context.Context, req *campaign.ResumeCampaignRequest) (*campaign.ResumeCampaignResponse, error) {
+	updatedCampaign, err := s.changeCampaignStatus(ctx, req.Id, req.Etag, models.StatusActive, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &campaign.ResumeCampaignResponse{Campaign: helper.MapCampaignProto(updatedCampaign)}, nil
+}
+
+func (s *campaignService) CancelCampaign(ctx context.Context, req *campaign.CancelCampaignRequest) (*campaign.CancelCampaignResponse, error) {
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required to cancel a campaign")
+	}
+	updatedCampaign, err := s.changeCampaignStatus(ctx, req.Id, req.Etag, models.StatusCancelled, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &campaign.CancelCampaignResponse{Campaign: helper.MapCampaignProto(updatedCampaign)}, nil
+}
+
+func (s *campaignService) PublishCampaign(ctx context.Context, req *campaign.PublishCampaignRequest) (*campaign.PublishCampaignResponse, error) {
+	// Campaigns go live after moderation, so publishing is kept as an alias of SubmitForReview
+	submitted, err := s.SubmitForReview(ctx, &campaign.SubmitForReviewRequest{Id: req.Id, Etag: req.Etag})
+	if err != nil {
+		return nil, err
+	}
+	return &campaign.PublishCampaignResponse{Campaign: submitted.Campaign}, nil
+}
+
+func (s *campaignService) SubmitForReview(ctx context.Context, req *campaign.SubmitForReviewRequest) (*campaign.SubmitForReviewResponse, error) {
+	// The repository checks that the campaign is complete before it is reviewed
+	updatedCampaign, err := s.changeCampaignStatus(ctx, req.Id, req.Etag, models.StatusPendingReview, "submitted for review")
+	if err != nil {
+		return nil, err
+	}
+	return &campaign.SubmitForReviewResponse{Campaign: helper.MapCampaignProto(updatedCampaign)}, nil
+}
+
+func (s *campaignService) ApproveCampaign(ctx context.Context, req *campaign.ApproveCampaignRequest) (*campaign.ApproveCampaignResponse, error) {
+	moderator, err := requireRole(ctx, auth.RoleModerator, auth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCampaign, err := s.reviewCampaign(ctx, req.Id, req.Etag, moderator.UserID, models.StatusActive, "approved")
+	if err != nil {
+		return nil, err
+	}
+	return &campaign.ApproveCampaignResponse{Campaign: helper.MapCampaignProto(updatedCampaign)}, nil
+}
+
+func (s *campaignService) RejectCampaign(ctx context.Context, req *campaign.RejectCampaignRequest) (*campaign.RejectCampaignResponse, error) {
+	moderator, err := requireRole(ctx, auth.RoleModerator, auth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required to reject a campaign")
+	}
+
+	updatedCampaign, err := s.reviewCampaign(ctx, req.Id, req.Etag, moderator.UserID, models.StatusRejected, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &campaign.RejectCampaignResponse{Campaign: helper.MapCampaignProto(updatedCampaign)}, nil
+}
+
+func (s *campaignService) ListPendingReviews(ctx context.Context, req *campaign.ListPendingReviewsRequest) (*campaign.ListPendingReviewsResponse, error) {
+	if _, err := requireRole(ctx, auth.RoleModerator, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	// Oldest submissions first
+	page, err := s.campaignRepo.ListCampaigns(ctx, repository.CampaignListOptions{
+		Statuses:  []string{models.StatusPendingReview},
+		SortBy:    "created_at",
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.ListPendingReviewsResponse{
+		Campaign:      helper.MapCampaignListProto(page.Campaigns),
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
+
+// reviewCampaign applies a moderator decision to a campaign waiting for review
+func (s *campaignService) reviewCampaign(ctx context.Context, id string, etag string, moderatorID int32, to string, reason string) (models.CampaignDB, error) {
+	version, err := helper.MapEtagVersion(etag)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	reviewedCampaign, err := s.campaignRepo.ReviewCampaign(ctx, id, moderatorID, version, to, reason)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	return reviewedCampaign, nil
+}
+
+func (s *campaignService) ForceCancelCampaign(ctx context.Context, req *campaign.ForceCancelCampaignRequest) (*campaign.ForceCancelCampaignResponse, error) {
+	admin, err := requireRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required to cancel a campaign")
+	}
+
+	// Cancel from any status, skipping the owner transition rules
+	cancelledCampaign, err := s.campaignRepo.ForceCancelCampaign(ctx, req.Id, admin.UserID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.ForceCancelCampaignResponse{Campaign: helper.MapCampaignProto(cancelledCampaign)}, nil
+}
+
+func (s *campaignService) ListAllCampaigns(ctx context.Context, req *campaign.ListAllCampaignsRequest) (*campaign.ListAllCampaignsResponse, error) {
+	if _, err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	// Admins see every status unless they filter
+	opts := repository.CampaignListOptions{
+		Statuses:       models.AllStatuses,
+		IncludeDeleted: req.IncludeDeleted,
+		PageSize:       int(req.PageSize),
+		PageToken:      req.PageToken,
+	}
+	if len(req.Statuses) > 0 {
+		opts.Statuses = nil
+		for _, val := range req.Statuses {
+			mapped := helper.MapStatusDB(int32(val))
+			if mapped == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown campaign status %v", val)
+			}
+			opts.Statuses = append(opts.Statuses, mapped)
+		}
+	}
+
+	page, err := s.campaignRepo.ListCampaigns(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.ListAllCampaignsResponse{
+		Campaign:      helper.MapCampaignListProto(page.Campaigns),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// publicStatuses maps a status filter of a public listing, rejecting statuses only owners and moderators may see
+func publicStatuses(input []campaign.CampaignStatus) ([]string, error) {
+	var result []string
+	for _, val := range input {
+		mapped := helper.MapStatusDB(int32(val))
+		if !slices.Contains(models.PublicStatuses, mapped) {
+			return nil, status.Errorf(codes.InvalidArgument, "campaigns with status %v are not public", val)
+		}
+		result = append(result, mapped)
+	}
+	return result, nil
+}
+
+// changeCampaignStatus moves a campaign of the caller to another status following models.CampaignTransitions
+func (s *campaignService) changeCampaignStatus(ctx context.Context, id string, etag string, to string, reason string) (models.CampaignDB, error) {
+	version, err := helper.MapEtagVersion(etag)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	// Only the owner or an admin can change the status
+	ownerID, caller, err := s.ownerIDForCaller(ctx, id)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	updatedCampaign, err := s.campaignRepo.ChangeCampaignStatus(ctx, id, ownerID, caller.UserID, version, to, reason)
+	if err != nil {
+		return models.CampaignDB{}, err
+	}
+
+	return updatedCampaign, nil
+}
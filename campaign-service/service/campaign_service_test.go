@@ -0,0 +1,75 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/auth"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/helper"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/service"
+)
+
+func TestAdminPauseIsRecordedAsAdmin(t *testing.T) {
+	campaignRepo := repository.NewMemoryCampaignRepository()
+	svc := service.NewCampaignService(campaignRepo, repository.NewMemoryCampaignUpdateRepository(), repository.NewMemoryIdempotencyRepository())
+
+	owned, err := campaignRepo.CreateCampaign(context.Background(), models.CampaignDB{
+		ID:           uuid.NewString(),
+		UserID:       1,
+		Title:        "Clean water for Sumba",
+		Description:  "Wells for three villages in East Sumba",
+		TargetAmount: 1_000_000,
+		MinDonation:  10_000,
+		Deadline:     time.Now().Add(30 * 24 * time.Hour),
+		Status:       models.StatusActive,
+		Category:     "community",
+	})
+	if err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+
+	admin := auth.Caller{UserID: 900, Roles: []string{auth.RoleAdmin}}
+	ctx := auth.NewContext(context.Background(), admin)
+	res, err := svc.PauseCampaign(ctx, &campaign.PauseCampaignRequest{Id: owned.ID, Etag: helper.MapEtagProto(owned.Version), Reason: "reported by donors"})
+	if err != nil {
+		t.Fatalf("PauseCampaign: %v", err)
+	}
+	if res.Campaign.Status != campaign.CampaignStatus_CAMPAIGN_STATUS_PAUSED {
+		t.Errorf("status = %v, want paused", res.Campaign.Status)
+	}
+
+	history, err := campaignRepo.GetStatusHistory(context.Background(), owned.ID)
+	if err != nil {
+		t.Fatalf("GetStatusHistory: %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("history has %d changes, want 1", len(history))
+	}
+	if history[0].UserID != admin.UserID || history[0].Reason != "reported by donors" {
+		t.Errorf("history records user %d with reason %q, want admin %d with the admin's reason", history[0].UserID, history[0].Reason, admin.UserID)
+	}
+}
+
+// News posts are written by the campaign owner only, the policy must not let admins in
+// just for the handler to reject them
+func TestCampaignUpdatesAreOwnerOnly(t *testing.T) {
+	admin := auth.NewContext(context.Background(), auth.Caller{UserID: 900, Roles: []string{auth.RoleAdmin}})
+	methods := []string{
+		campaign.CampaignService_CreateCampaignUpdate_FullMethodName,
+		campaign.CampaignService_EditCampaignUpdate_FullMethodName,
+		campaign.CampaignService_DeleteCampaignUpdate_FullMethodName,
+	}
+	for _, method := range methods {
+		if err := service.CampaignPolicy.Authorize(admin, method); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("admin calling %v = %v, want PermissionDenied", method, err)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/auth"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/helper"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+func (s *campaignService) CreateCampaignUpdate(ctx context.Context, req *campaign.CreateCampaignUpdateRequest) (*campaign.CreateCampaignUpdateResponse, error) {
+	// Only the owner of a campaign that is not cancelled can post
+	caller, err := s.requireCampaignOwner(ctx, req.CampaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a new uuid
+	uuid := uuid.New()
+
+	// Insert update to database
+	createdUpdate, err := s.campaignUpdateRepo.CreateCampaignUpdate(ctx, models.CampaignUpdateDB{
+		ID:         uuid.String(),
+		CampaignID: req.CampaignId,
+		UserID:     caller.UserID,
+		Title:      req.Title,
+		Content:    req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.CreateCampaignUpdateResponse{
+		CampaignUpdate: helper.MapCampaignUpdateProto(createdUpdate),
+	}, nil
+}
+
+func (s *campaignService) ListCampaignUpdates(ctx context.Context, req *campaign.ListCampaignUpdatesRequest) (*campaign.ListCampaignUpdatesResponse, error) {
+	// Updates of campaigns that are not public are only shown to their owner and staff
+	if _, err := s.visibleCampaign(ctx, req.CampaignId); err != nil {
+		return nil, err
+	}
+
+	// List updates of the campaign, newest first
+	page, err := s.campaignUpdateRepo.ListCampaignUpdates(ctx, req.CampaignId, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.ListCampaignUpdatesResponse{
+		CampaignUpdate: helper.MapCampaignUpdateListProto(page.Updates),
+		NextPageToken:  page.NextPageToken,
+	}, nil
+}
+
+func (s *campaignService) EditCampaignUpdate(ctx context.Context, req *campaign.EditCampaignUpdateRequest) (*campaign.EditCampaignUpdateResponse, error) {
+	// Check the caller owns the campaign the update belongs to
+	existingUpdate, err := s.getCampaignUpdate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requireCampaignOwner(ctx, existingUpdate.CampaignID); err != nil {
+		return nil, err
+	}
+
+	// Update title and content
+	editedUpdate, err := s.campaignUpdateRepo.EditCampaignUpdate(ctx, req.Id, models.CampaignUpdateDB{
+		Title:   req.Title,
+		Content: req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &campaign.EditCampaignUpdateResponse{
+		CampaignUpdate: helper.MapCampaignUpdateProto(editedUpdate),
+	}, nil
+}
+
+func (s *campaignService) DeleteCampaignUpdate(ctx context.Context, req *campaign.DeleteCampaignUpdateRequest) (*campaign.DeleteCampaignUpdateResponse, error) {
+	// Check the caller owns the campaign the update belongs to
+	existingUpdate, err := s.getCampaignUpdate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requireCampaignOwner(ctx, existingUpdate.CampaignID); err != nil {
+		return nil, err
+	}
+
+	// Delete update by id
+	if err := s.campaignUpdateRepo.DeleteCampaignUpdate(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &campaign.DeleteCampaignUpdateResponse{
+		DeleteResponse: &emptypb.Empty{},
+	}, nil
+}
+
+// getCampaignUpdate loads a campaign update that is not deleted
+func (s *campaignService) getCampaignUpdate(ctx context.Context, id string) (models.CampaignUpdateDB, error) {
+	update, err := s.campaignUpdateRepo.GetCampaignUpdateByID(ctx, id)
+	if err != nil {
+		return models.CampaignUpdateDB{}, err
+	}
+
+	return update, nil
+}
+
+// requireCampaignOwner returns the caller when it owns the campaign and the campaign is not cancelled
+func (s *campaignService) requireCampaignOwner(ctx context.Context, campaignID string) (auth.Caller, error) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.Caller{}, status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+
+	ownedCampaign, err := s.campaignRepo.GetCampaignByID(ctx, campaignID)
+	if err != nil {
+		return auth.Caller{}, err
+	}
+
+	if ownedCampaign.UserID != caller.UserID {
+		return auth.Caller{}, status.Error(codes.PermissionDenied, "only the campaign owner can manage its updates")
+	}
+	if ownedCampaign.Status == models.StatusCancelled {
+		return auth.Caller{}, status.Error(codes.FailedPrecondition, "campaign is cancelled")
+	}
+	return caller, nil
+}
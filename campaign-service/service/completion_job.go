@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+)
+
+// CompletionJob periodically completes campaigns whose deadline passed or whose target was reached.
+// Running it on several replicas is safe, the repository lets only one of them work at a time.
+type CompletionJob struct {
+	campaignRepo repository.CampaignRepository
+	interval     time.Duration
+}
+
+// NewCompletionJob initializes and returns a new CompletionJob running every interval
+func NewCompletionJob(campaignRepo repository.CampaignRepository, interval time.Duration) *CompletionJob {
+	return &CompletionJob{campaignRepo: campaignRepo, interval: interval}
+}
+
+// Run blocks and completes due campaigns on every tick until ctx is cancelled
+func (j *CompletionJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.runOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *CompletionJob) runOnce(ctx context.Context) {
+	completed, err := j.campaignRepo.CompleteDueCampaigns(ctx, time.Now())
+	if err != nil {
+		log.Printf("Failed to complete due campaigns: %v", err)
+		return
+	}
+	if completed > 0 {
+		log.Printf("Completed %d campaigns", completed)
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+)
+
+// errorDomain is the google.rpc.ErrorInfo domain of every reason code this service returns
+const errorDomain = "campaign.crowdfunding-app"
+
+// errorCodes maps each kind of repository error to its gRPC status code
+var errorCodes = map[error]codes.Code{
+	repository.ErrNotFound:          codes.NotFound,
+	repository.ErrAlreadyExists:     codes.AlreadyExists,
+	repository.ErrConflict:          codes.Aborted,
+	repository.ErrInvalidTransition: codes.FailedPrecondition,
+	repository.ErrPrecondition:      codes.FailedPrecondition,
+	repository.ErrInvalidArgument:   codes.InvalidArgument,
+	repository.ErrPermissionDenied:  codes.PermissionDenied,
+	repository.ErrUnavailable:       codes.Unavailable,
+	repository.ErrDeadlineExceeded:  codes.DeadlineExceeded,
+	repository.ErrCanceled:          codes.Canceled,
+	repository.ErrInternal:          codes.Internal,
+}
+
+// errorStatus converts err into a gRPC status error. Status errors pass through unchanged,
+// domain errors get their code and an ErrorInfo with the reason, context errors become
+// DeadlineExceeded or Canceled and anything else is an internal error whose message is
+// logged rather than sent to the client.
+func errorStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var domainErr *repository.Error
+	if !errors.As(err, &domainErr) {
+		// Context errors that did not pass through the repository, e.g. while waiting on a lock
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return status.FromContextError(err).Err()
+		}
+		log.Printf("internal error: %v", err)
+		return withDetails(status.New(codes.Internal, "internal error"), &errdetails.ErrorInfo{Reason: "INTERNAL", Domain: errorDomain})
+	}
+
+	code, ok := errorCodes[domainErr.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+	if code == codes.Internal || code == codes.Unavailable {
+		log.Printf("%v: %v", domainErr.Reason, err)
+	}
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   domainErr.Reason,
+		Domain:   errorDomain,
+		Metadata: domainErr.Metadata,
+	}}
+	if len(domainErr.Violations) > 0 {
+		failure := &errdetails.PreconditionFailure{}
+		for _, val := range domainErr.Violations {
+			failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        val.Type,
+				Subject:     val.Subject,
+				Description: val.Description,
+			})
+		}
+		details = append(details, failure)
+	}
+	return withDetails(status.New(code, domainErr.Message), details...)
+}
+
+// withDetails attaches details to st, falling back to the bare status if they cannot be encoded
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// UnaryErrorInterceptor maps errors returned by handlers and inner interceptors to gRPC statuses.
+// It has to be the first interceptor of the chain.
+func UnaryErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		res, err := handler(ctx, req)
+		if err != nil {
+			return nil, errorStatus(err)
+		}
+		return res, nil
+	}
+}
+
+// StreamErrorInterceptor maps errors returned by streaming handlers to gRPC statuses
+func StreamErrorInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return errorStatus(handler(srv, ss))
+	}
+}
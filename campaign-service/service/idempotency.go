@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/repository"
+)
+
+// idempotencyMetadataKey is the gRPC metadata header that can carry an idempotency key
+const idempotencyMetadataKey = "idempotency-key"
+
+// idempotencyKey returns the key from the request field, falling back to gRPC metadata
+func idempotencyKey(ctx context.Context, fieldKey string) string {
+	if fieldKey != "" {
+		return fieldKey
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(idempotencyMetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// runIdempotent executes call at most once per key and operation. Replays with the same key
+// get the stored response of the first successful call; failed calls release the key again.
+func runIdempotent[T proto.Message](ctx context.Context, repo repository.IdempotencyRepository, key string, operation string, req proto.Message, newResponse func() T, call func() (T, error)) (T, error) {
+	var empty T
+	if key == "" {
+		return call()
+	}
+
+	// Hash the request so a key cannot be reused for a different payload
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return empty, status.Error(codes.Internal, "failed to hash request")
+	}
+	sum := sha256.Sum256(payload)
+	requestHash := hex.EncodeToString(sum[:])
+
+	record, reserved, err := repo.ReserveKey(ctx, key, operation, requestHash)
+	if err != nil {
+		return empty, err
+	}
+
+	// Replay the stored response of an earlier request
+	if !reserved {
+		if record.RequestHash != requestHash {
+			return empty, status.Error(codes.InvalidArgument, "idempotency key was already used with a different request")
+		}
+		if record.Response == nil {
+			return empty, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+		}
+		res := newResponse()
+		if err := proto.Unmarshal(record.Response, res); err != nil {
+			return empty, status.Error(codes.Internal, "failed to read stored response")
+		}
+		return res, nil
+	}
+
+	res, err := call()
+	// Releasing or completing the key has to happen even when the client has gone away
+	bookkeepingCtx := context.WithoutCancel(ctx)
+	if err != nil {
+		if releaseErr := repo.ReleaseKey(bookkeepingCtx, key, operation); releaseErr != nil {
+			return empty, releaseErr
+		}
+		return empty, err
+	}
+
+	// The write already happened, so failing to store the response must not fail the call
+	response, err := proto.Marshal(res)
+	if err == nil {
+		err = repo.CompleteKey(bookkeepingCtx, key, operation, response)
+	}
+	if err != nil {
+		log.Printf("failed to store response for idempotency key %s (%s): %v", key, operation, err)
+	}
+	return res, nil
+}
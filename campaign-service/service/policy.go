@@ -0,0 +1,46 @@
+package service
+
+import (
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/auth"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+)
+
+// CampaignPolicy lists the roles allowed to call each CampaignService RPC. Ownership of a
+// campaign is checked by the handlers on top of this. RPCs missing here are denied.
+var CampaignPolicy = auth.Policy{
+	// Browsing is public, campaigns that are not public yet are only shown to their owner and staff
+	campaign.CampaignService_GetCampaignByID_FullMethodName:      {auth.Everyone},
+	campaign.CampaignService_GetCampaignsByUserID_FullMethodName: {auth.Everyone},
+	campaign.CampaignService_ListCampaigns_FullMethodName:        {auth.Everyone},
+	campaign.CampaignService_SearchCampaigns_FullMethodName:      {auth.Everyone},
+	campaign.CampaignService_ListCampaignUpdates_FullMethodName:  {auth.Everyone},
+
+	// Organizers manage their own campaigns, admins manage any campaign
+	campaign.CampaignService_CreateCampaign_FullMethodName:     {auth.RoleOrganizer, auth.RoleAdmin},
+	campaign.CampaignService_UpdateCampaignByID_FullMethodName: {auth.RoleOrganizer, auth.RoleAdmin},
+	campaign.CampaignService_DeleteCampaignByID_FullMethodName: {auth.RoleOrganizer, auth.RoleAdmin},
+	campaign.CampaignService_PauseCampaign_FullMethodName:      {auth.RoleOrganizer, auth.RoleAdmin},
+	campaign.CampaignService_ResumeCampaign_FullMethodName:     {auth.RoleOrganizer, auth.RoleAdmin},
+	campaign.CampaignService_CancelCampaign_FullMethodName:     {auth.RoleOrganizer, auth.RoleAdmin},
+	campaign.CampaignService_PublishCampaign_FullMethodName:    {auth.RoleOrganizer, auth.RoleAdmin},
+	campaign.CampaignService_SubmitForReview_FullMethodName:    {auth.RoleOrganizer, auth.RoleAdmin},
+
+	// News posts speak for the campaign, so only its owner writes them
+	campaign.CampaignService_CreateCampaignUpdate_FullMethodName: {auth.RoleOrganizer},
+	campaign.CampaignService_EditCampaignUpdate_FullMethodName:   {auth.RoleOrganizer},
+	campaign.CampaignService_DeleteCampaignUpdate_FullMethodName: {auth.RoleOrganizer},
+
+	// Donations are booked by the donation service
+	campaign.CampaignService_RecordContribution_FullMethodName:  {auth.RoleService, auth.RoleAdmin},
+	campaign.CampaignService_ReverseContribution_FullMethodName: {auth.RoleService, auth.RoleAdmin},
+
+	// Moderation
+	campaign.CampaignService_ApproveCampaign_FullMethodName:    {auth.RoleModerator, auth.RoleAdmin},
+	campaign.CampaignService_RejectCampaign_FullMethodName:     {auth.RoleModerator, auth.RoleAdmin},
+	campaign.CampaignService_ListPendingReviews_FullMethodName: {auth.RoleModerator, auth.RoleAdmin},
+
+	// Support staff only
+	campaign.CampaignService_ReconcileCampaign_FullMethodName:   {auth.RoleAdmin},
+	campaign.CampaignService_ForceCancelCampaign_FullMethodName: {auth.RoleAdmin},
+	campaign.CampaignService_ListAllCampaigns_FullMethodName:    {auth.RoleAdmin},
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/helper"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/models"
+)
+
+// campaignMaskColumns maps the update_mask paths of UpdateCampaignByIDRequest to campaign columns
+var campaignMaskColumns = map[string]string{
+	"title":         "title",
+	"description":   "description",
+	"target_amount": "target_amount",
+	"deadline":      "deadline",
+	"status":        "status",
+	"category":      "category",
+	"min_donation":  "min_donation",
+}
+
+// campaignImmutablePaths are request fields that identify the campaign or the request and can never be updated
+var campaignImmutablePaths = map[string]bool{
+	"id":          true,
+	"user_id":     true,
+	"etag":        true,
+	"update_mask": true,
+}
+
+// campaignUpdateMaskPaths returns the paths to update. Without a mask every field set to a
+// non-zero value is updated, which is how the RPC behaved before update_mask existed.
+func campaignUpdateMaskPaths(req *campaign.UpdateCampaignByIDRequest) []string {
+	if len(req.GetUpdateMask().GetPaths()) > 0 {
+		return req.UpdateMask.Paths
+	}
+
+	var paths []string
+	if req.Title != "" {
+		paths = append(paths, "title")
+	}
+	if req.Description != "" {
+		paths = append(paths, "description")
+	}
+	if req.TargetAmount != nil {
+		paths = append(paths, "target_amount")
+	}
+	if req.Deadline != nil {
+		paths = append(paths, "deadline")
+	}
+	if req.Status != campaign.CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED {
+		paths = append(paths, "status")
+	}
+	if req.Category != campaign.CampaignCategory_CAMPAIGN_CATEGORY_UNSPECIFIED {
+		paths = append(paths, "category")
+	}
+	if req.MinDonation != nil {
+		paths = append(paths, "min_donation")
+	}
+	return paths
+}
+
+// campaignUpdateFields builds the campaign payload and the columns to write from the masked
+// fields of req. Masked fields are applied even when they hold zero values, so the rules that
+// only apply to written fields are checked here rather than by the validation interceptor.
+// The minimum donation is compared with the stored target by the repository.
+func campaignUpdateFields(req *campaign.UpdateCampaignByIDRequest) (models.CampaignDB, []string, error) {
+	var payload models.CampaignDB
+	var columns []string
+	var amounts []*campaign.Money
+
+	for _, path := range campaignUpdateMaskPaths(req) {
+		if campaignImmutablePaths[path] {
+			return models.CampaignDB{}, nil, status.Errorf(codes.InvalidArgument, "field %q cannot be updated", path)
+		}
+		column, ok := campaignMaskColumns[path]
+		if !ok {
+			return models.CampaignDB{}, nil, status.Errorf(codes.InvalidArgument, "unknown field %q in update_mask", path)
+		}
+
+		switch path {
+		case "title":
+			if strings.TrimSpace(req.Title) == "" {
+				return models.CampaignDB{}, nil, invalidField("title", "must not be blank")
+			}
+			payload.Title = req.Title
+		case "description":
+			payload.Description = req.Description
+		case "target_amount":
+			if req.TargetAmount == nil || req.TargetAmount.Units <= 0 {
+				return models.CampaignDB{}, nil, invalidField("target_amount.units", "must be greater than zero")
+			}
+			payload.TargetAmount = helper.MapMoneyUnits(req.TargetAmount)
+			amounts = append(amounts, req.TargetAmount)
+		case "min_donation":
+			payload.MinDonation = helper.MapMoneyUnits(req.MinDonation)
+			amounts = append(amounts, req.MinDonation)
+		case "deadline":
+			if req.Deadline == nil {
+				return models.CampaignDB{}, nil, invalidField("deadline", "is required")
+			}
+			if !req.Deadline.AsTime().After(time.Now()) {
+				return models.CampaignDB{}, nil, invalidField("deadline", "must be in the future")
+			}
+			payload.Deadline = req.Deadline.AsTime()
+		case "status":
+			payload.Status = helper.MapStatusDB(int32(req.Status))
+			if payload.Status == "" {
+				return models.CampaignDB{}, nil, status.Errorf(codes.InvalidArgument, "status %v cannot be set", req.Status)
+			}
+		case "category":
+			payload.Category = helper.MapCategoryDB(int32(req.Category))
+			if payload.Category == "" {
+				return models.CampaignDB{}, nil, status.Errorf(codes.InvalidArgument, "unknown category %v", req.Category)
+			}
+		}
+		columns = append(columns, column)
+	}
+
+	// Amounts in the request have to be in the same currency. The repository checks it against the
+	// campaign and only lets a draft switch currency when both amounts are sent.
+	currency, err := helper.CommonCurrency(amounts...)
+	if err != nil {
+		return models.CampaignDB{}, nil, err
+	}
+	if currency != "" {
+		payload.Currency = currency
+		columns = append(columns, "currency")
+	}
+	return payload, columns, nil
+}
+
+// invalidField returns InvalidArgument with a google.rpc.BadRequest for a single field,
+// shaped like the errors of the validation interceptor
+func invalidField(field string, description string) error {
+	st := status.New(codes.InvalidArgument, "invalid request: "+field+" "+description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}}})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
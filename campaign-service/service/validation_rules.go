@@ -0,0 +1,154 @@
+package service
+
+import (
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/validation"
+)
+
+// Limits of the database columns
+const (
+	maxTitleLength         = 200
+	maxDescriptionLength   = 255
+	maxReasonLength        = 255
+	maxExternalIDLength    = 255
+	maxSourceLength        = 50
+	maxSearchQueryLength   = 200
+	maxUpdateTitleLength   = 200
+	maxUpdateContentLength = 2000
+)
+
+// CampaignRules are the request rules enforced by the validation interceptor. Rules that need the
+// stored campaign, like currency and status checks, stay in the service and repository.
+var CampaignRules = validation.RuleSet{}.
+	Add(&campaign.CreateCampaignRequest{},
+		validation.Field("title", validation.NotBlank(), validation.MaxLength(maxTitleLength)),
+		validation.Field("description", validation.MaxLength(maxDescriptionLength)),
+		validation.Field("target_amount", validation.Required()),
+		validation.Field("target_amount.units", validation.Positive()),
+		validation.Field("min_donation.units", validation.NonNegative()),
+		validation.LessOrEqual("min_donation.units", "target_amount.units"),
+		validation.Field("deadline", validation.Required(), validation.Future()),
+		validation.Field("category", validation.Specified()),
+		validation.Field("idempotency_key", validation.MaxLength(maxExternalIDLength)),
+	).
+	Add(&campaign.GetCampaignByIDRequest{},
+		validation.Field("id", validation.UUID()),
+	).
+	Add(&campaign.DeleteCampaignByIDRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+	).
+	// Which fields are written depends on update_mask, so blank titles, missing targets and past
+	// deadlines are checked by campaignUpdateFields for the masked fields only
+	Add(&campaign.UpdateCampaignByIDRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+		validation.Field("title", validation.MaxLength(maxTitleLength)),
+		validation.Field("description", validation.MaxLength(maxDescriptionLength)),
+		validation.Field("target_amount.units", validation.NonNegative()),
+		validation.Field("min_donation.units", validation.NonNegative()),
+		validation.LessOrEqual("min_donation.units", "target_amount.units"),
+		validation.Field("status", validation.Defined()),
+		validation.Field("category", validation.Defined()),
+	).
+	Add(&campaign.GetCampaignsByUserIDRequest{},
+		validation.Field("user_id", validation.Positive()),
+		validation.Field("page_size", validation.NonNegative()),
+		validation.Field("statuses", validation.Specified()),
+	).
+	Add(&campaign.ListCampaignsRequest{},
+		validation.Field("statuses", validation.Specified()),
+		validation.Field("categories", validation.Specified()),
+		validation.LessOrEqual("deadline_from", "deadline_to"),
+		validation.Field("min_target_amount", validation.NonNegative()),
+		validation.Field("max_target_amount", validation.NonNegative()),
+		validation.LessOrEqual("min_target_amount", "max_target_amount"),
+		validation.Field("sort_by", validation.Defined()),
+		validation.Field("page_size", validation.NonNegative()),
+	).
+	Add(&campaign.SearchCampaignsRequest{},
+		validation.Field("query", validation.NotBlank(), validation.MaxLength(maxSearchQueryLength)),
+		validation.Field("statuses", validation.Specified()),
+		validation.Field("categories", validation.Specified()),
+		validation.Field("page_size", validation.NonNegative()),
+	).
+	Add(&campaign.RecordContributionRequest{},
+		validation.Field("campaign_id", validation.UUID()),
+		validation.Field("contribution_id", validation.NotBlank(), validation.MaxLength(maxExternalIDLength)),
+		validation.Field("amount", validation.Required()),
+		validation.Field("amount.units", validation.Positive()),
+		validation.Field("idempotency_key", validation.MaxLength(maxExternalIDLength)),
+		validation.Field("source", validation.MaxLength(maxSourceLength)),
+	).
+	Add(&campaign.ReverseContributionRequest{},
+		validation.Field("campaign_id", validation.UUID()),
+		validation.Field("contribution_id", validation.NotBlank(), validation.MaxLength(maxExternalIDLength)),
+		validation.Field("reversal_id", validation.NotBlank(), validation.MaxLength(maxExternalIDLength)),
+		validation.Field("amount", validation.Required()),
+		validation.Field("amount.units", validation.Positive()),
+		validation.Field("reason", validation.MaxLength(maxReasonLength)),
+		validation.Field("idempotency_key", validation.MaxLength(maxExternalIDLength)),
+	).
+	Add(&campaign.ReconcileCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+	).
+	Add(&campaign.PauseCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+		validation.Field("reason", validation.MaxLength(maxReasonLength)),
+	).
+	Add(&campaign.ResumeCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+		validation.Field("reason", validation.MaxLength(maxReasonLength)),
+	).
+	Add(&campaign.CancelCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+		validation.Field("reason", validation.NotBlank(), validation.MaxLength(maxReasonLength)),
+	).
+	Add(&campaign.PublishCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+	).
+	Add(&campaign.SubmitForReviewRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+	).
+	Add(&campaign.ApproveCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+	).
+	Add(&campaign.RejectCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("etag", validation.NotBlank()),
+		validation.Field("reason", validation.NotBlank(), validation.MaxLength(maxReasonLength)),
+	).
+	Add(&campaign.ListPendingReviewsRequest{},
+		validation.Field("page_size", validation.NonNegative()),
+	).
+	Add(&campaign.ForceCancelCampaignRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("reason", validation.NotBlank(), validation.MaxLength(maxReasonLength)),
+	).
+	Add(&campaign.ListAllCampaignsRequest{},
+		validation.Field("statuses", validation.Specified()),
+		validation.Field("page_size", validation.NonNegative()),
+	).
+	Add(&campaign.CreateCampaignUpdateRequest{},
+		validation.Field("campaign_id", validation.UUID()),
+		validation.Field("title", validation.NotBlank(), validation.MaxLength(maxUpdateTitleLength)),
+		validation.Field("content", validation.NotBlank(), validation.MaxLength(maxUpdateContentLength)),
+	).
+	Add(&campaign.ListCampaignUpdatesRequest{},
+		validation.Field("campaign_id", validation.UUID()),
+		validation.Field("page_size", validation.NonNegative()),
+	).
+	Add(&campaign.EditCampaignUpdateRequest{},
+		validation.Field("id", validation.UUID()),
+		validation.Field("title", validation.NotBlank(), validation.MaxLength(maxUpdateTitleLength)),
+		validation.Field("content", validation.NotBlank(), validation.MaxLength(maxUpdateContentLength)),
+	).
+	Add(&campaign.DeleteCampaignUpdateRequest{},
+		validation.Field("id", validation.UUID()),
+	)
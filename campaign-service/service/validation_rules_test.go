@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/service"
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/validation"
+)
+
+func TestCampaignRules(t *testing.T) {
+	interceptor := validation.UnaryServerInterceptor(service.CampaignRules)
+	campaignID := "0f8fad5b-d9cb-469f-a165-70867728950e"
+	future := timestamppb.New(time.Now().Add(24 * time.Hour))
+
+	cases := []struct {
+		name   string
+		req    proto.Message
+		fields []string
+	}{
+		{
+			name: "valid create",
+			req: &campaign.CreateCampaignRequest{
+				Title:        "Clean water for Sumba",
+				TargetAmount: &campaign.Money{CurrencyCode: "IDR", Units: 1_000_000},
+				MinDonation:  &campaign.Money{CurrencyCode: "IDR", Units: 10_000},
+				Deadline:     future,
+				Category:     campaign.CampaignCategory(1),
+			},
+		},
+		{
+			name:   "empty create",
+			req:    &campaign.CreateCampaignRequest{},
+			fields: []string{"title", "target_amount", "deadline", "category"},
+		},
+		{
+			name: "create with inverted amounts and a past deadline",
+			req: &campaign.CreateCampaignRequest{
+				Title:        strings.Repeat("a", 201),
+				TargetAmount: &campaign.Money{Units: 10},
+				MinDonation:  &campaign.Money{Units: 20},
+				Deadline:     timestamppb.New(time.Now().Add(-time.Hour)),
+				Category:     999,
+			},
+			fields: []string{"title", "min_donation.units", "deadline", "category"},
+		},
+		{name: "get with malformed id", req: &campaign.GetCampaignByIDRequest{Id: "1"}, fields: []string{"id"}},
+		{name: "pause without etag", req: &campaign.PauseCampaignRequest{Id: campaignID}, fields: []string{"etag"}},
+		{name: "cancel without reason", req: &campaign.CancelCampaignRequest{Id: campaignID, Etag: "1"}, fields: []string{"reason"}},
+		{
+			name:   "list with unspecified status and negative page size",
+			req:    &campaign.GetCampaignsByUserIDRequest{UserId: 1, PageSize: -1, Statuses: []campaign.CampaignStatus{campaign.CampaignStatus_CAMPAIGN_STATUS_PAUSED, 0}},
+			fields: []string{"page_size", "statuses[1]"},
+		},
+		{name: "blank search", req: &campaign.SearchCampaignsRequest{Query: "  "}, fields: []string{"query"}},
+		{name: "contribution without amount", req: &campaign.RecordContributionRequest{CampaignId: campaignID, ContributionId: "c-1"}, fields: []string{"amount"}},
+		{name: "blank campaign update", req: &campaign.CreateCampaignUpdateRequest{CampaignId: campaignID}, fields: []string{"title", "content"}},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			_, err := interceptor(context.Background(), val.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			if val.fields == nil {
+				if err != nil {
+					t.Errorf("valid request rejected: %v", err)
+				}
+				return
+			}
+
+			st := status.Convert(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("code = %v, want InvalidArgument", st.Code())
+			}
+			var fields []string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, violation := range badRequest.FieldViolations {
+						fields = append(fields, violation.Field)
+					}
+				}
+			}
+			if !slices.Equal(fields, val.fields) {
+				t.Errorf("fields = %v, want %v", fields, val.fields)
+			}
+		})
+	}
+}
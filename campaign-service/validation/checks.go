@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Check validates a single value. It returns a description of what is wrong, or "" when the value is
+// valid. set is false for a missing message or optional field, only Required rejects those.
+type Check func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string
+
+// Required rejects missing messages and optional fields
+func Required() Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if !set {
+			return "is required"
+		}
+		return ""
+	}
+}
+
+// NotBlank rejects strings that are empty or only whitespace
+func NotBlank() Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if set && strings.TrimSpace(value.String()) == "" {
+			return "must not be blank"
+		}
+		return ""
+	}
+}
+
+// MaxLength rejects strings longer than max characters
+func MaxLength(max int) Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if set && utf8.RuneCountInString(value.String()) > max {
+			return fmt.Sprintf("must be at most %d characters", max)
+		}
+		return ""
+	}
+}
+
+// UUID rejects strings that are not a UUID
+func UUID() Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if !set {
+			return ""
+		}
+		if _, err := uuid.Parse(value.String()); err != nil {
+			return "must be a valid UUID"
+		}
+		return ""
+	}
+}
+
+// Positive rejects integers that are zero or negative
+func Positive() Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if set && value.Int() <= 0 {
+			return "must be greater than 0"
+		}
+		return ""
+	}
+}
+
+// NonNegative rejects negative integers
+func NonNegative() Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if set && value.Int() < 0 {
+			return "must not be negative"
+		}
+		return ""
+	}
+}
+
+// Defined rejects enum numbers that have no value in the enum
+func Defined() Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if set && field.Enum().Values().ByNumber(value.Enum()) == nil {
+			return fmt.Sprintf("must be a value of %v", field.Enum().Name())
+		}
+		return ""
+	}
+}
+
+// Specified rejects the zero (UNSPECIFIED) value of an enum as well as undefined numbers
+func Specified() Check {
+	defined := Defined()
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if !set {
+			return ""
+		}
+		if value.Enum() == 0 {
+			return "must be specified"
+		}
+		return defined(field, value, set)
+	}
+}
+
+// Future rejects timestamps that are not after the current time
+func Future() Check {
+	return func(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool) string {
+		if !set {
+			return ""
+		}
+		timestamp, ok := value.Message().Interface().(*timestamppb.Timestamp)
+		if !ok {
+			return ""
+		}
+		if !timestamp.AsTime().After(time.Now()) {
+			return "must be in the future"
+		}
+		return ""
+	}
+}
+
+// orderedValue converts integers and timestamps into numbers that can be ordered
+func orderedValue(field protoreflect.FieldDescriptor, value protoreflect.Value) (int64, bool) {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return value.Int(), true
+	case protoreflect.MessageKind:
+		if timestamp, ok := value.Message().Interface().(*timestamppb.Timestamp); ok {
+			return timestamp.AsTime().UnixNano(), true
+		}
+	}
+	return 0, false
+}
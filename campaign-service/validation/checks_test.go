@@ -0,0 +1,85 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+)
+
+// runCheck applies check to the top level field name of msg the way Field rules do
+func runCheck(t *testing.T, check Check, msg proto.Message, name string) string {
+	t.Helper()
+	reflected := msg.ProtoReflect()
+	field := reflected.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil {
+		t.Fatalf("%v has no field %q", reflected.Descriptor().FullName(), name)
+	}
+	return check(field, reflected.Get(field), !field.HasPresence() || reflected.Has(field))
+}
+
+func TestChecks(t *testing.T) {
+	past := timestamppb.New(time.Now().Add(-time.Hour))
+	future := timestamppb.New(time.Now().Add(time.Hour))
+	negative := int64(-5)
+	zero := int64(0)
+
+	cases := []struct {
+		name  string
+		check Check
+		msg   proto.Message
+		field string
+		want  string
+	}{
+		{"required message set", Required(), &campaign.CreateCampaignRequest{TargetAmount: &campaign.Money{}}, "target_amount", ""},
+		{"required message missing", Required(), &campaign.CreateCampaignRequest{}, "target_amount", "is required"},
+		{"required optional missing", Required(), &campaign.ListCampaignsRequest{}, "min_target_amount", "is required"},
+		{"required optional zero", Required(), &campaign.ListCampaignsRequest{MinTargetAmount: &zero}, "min_target_amount", ""},
+		{"required scalar zero", Required(), &campaign.CreateCampaignRequest{}, "title", ""},
+
+		{"not blank", NotBlank(), &campaign.CreateCampaignRequest{Title: "Wells"}, "title", ""},
+		{"not blank empty", NotBlank(), &campaign.CreateCampaignRequest{}, "title", "must not be blank"},
+		{"not blank whitespace", NotBlank(), &campaign.CreateCampaignRequest{Title: " \t\n"}, "title", "must not be blank"},
+
+		{"max length", MaxLength(5), &campaign.CreateCampaignRequest{Title: "Wells"}, "title", ""},
+		{"max length counts characters", MaxLength(5), &campaign.CreateCampaignRequest{Title: "Sumbä"}, "title", ""},
+		{"max length exceeded", MaxLength(5), &campaign.CreateCampaignRequest{Title: "Wells!"}, "title", "must be at most 5 characters"},
+
+		{"uuid", UUID(), &campaign.GetCampaignByIDRequest{Id: "0f8fad5b-d9cb-469f-a165-70867728950e"}, "id", ""},
+		{"uuid empty", UUID(), &campaign.GetCampaignByIDRequest{}, "id", "must be a valid UUID"},
+		{"uuid malformed", UUID(), &campaign.GetCampaignByIDRequest{Id: "campaign-1"}, "id", "must be a valid UUID"},
+
+		{"positive", Positive(), &campaign.GetCampaignsByUserIDRequest{UserId: 1}, "user_id", ""},
+		{"positive zero", Positive(), &campaign.GetCampaignsByUserIDRequest{}, "user_id", "must be greater than 0"},
+		{"positive negative", Positive(), &campaign.GetCampaignsByUserIDRequest{UserId: -1}, "user_id", "must be greater than 0"},
+		{"positive optional missing", Positive(), &campaign.ListCampaignsRequest{}, "min_target_amount", ""},
+
+		{"non negative zero", NonNegative(), &campaign.GetCampaignsByUserIDRequest{}, "page_size", ""},
+		{"non negative negative", NonNegative(), &campaign.GetCampaignsByUserIDRequest{PageSize: -1}, "page_size", "must not be negative"},
+		{"non negative optional missing", NonNegative(), &campaign.ListCampaignsRequest{}, "min_target_amount", ""},
+		{"non negative optional negative", NonNegative(), &campaign.ListCampaignsRequest{MinTargetAmount: &negative}, "min_target_amount", "must not be negative"},
+
+		{"defined", Defined(), &campaign.CreateCampaignRequest{Category: campaign.CampaignCategory(1)}, "category", ""},
+		{"defined unspecified", Defined(), &campaign.CreateCampaignRequest{}, "category", ""},
+		{"defined unknown number", Defined(), &campaign.CreateCampaignRequest{Category: 999}, "category", "must be a value of CampaignCategory"},
+
+		{"specified", Specified(), &campaign.CreateCampaignRequest{Category: campaign.CampaignCategory(1)}, "category", ""},
+		{"specified unspecified", Specified(), &campaign.CreateCampaignRequest{}, "category", "must be specified"},
+		{"specified unknown number", Specified(), &campaign.CreateCampaignRequest{Category: 999}, "category", "must be a value of CampaignCategory"},
+
+		{"future", Future(), &campaign.CreateCampaignRequest{Deadline: future}, "deadline", ""},
+		{"future past", Future(), &campaign.CreateCampaignRequest{Deadline: past}, "deadline", "must be in the future"},
+		{"future missing", Future(), &campaign.CreateCampaignRequest{}, "deadline", ""},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			if got := runCheck(t, val.check, val.msg, val.field); got != val.want {
+				t.Errorf("check = %q, want %q", got, val.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(RuleSet{}.Add(&campaign.CreateCampaignRequest{},
+		Field("title", NotBlank()),
+		Field("target_amount", Required()),
+		Field("min_donation.units", NonNegative()),
+	))
+	info := &grpc.UnaryServerInfo{FullMethod: campaign.CampaignService_CreateCampaign_FullMethodName}
+
+	var handled bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = true
+		return nil, nil
+	}
+	_, err := interceptor(context.Background(), &campaign.CreateCampaignRequest{MinDonation: &campaign.Money{Units: -1}}, info, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", status.Code(err))
+	}
+	if want := []string{"title", "target_amount", "min_donation.units"}; !slices.Equal(violatedFields(t, err), want) {
+		t.Errorf("fields = %v, want %v", violatedFields(t, err), want)
+	}
+	if handled {
+		t.Error("handler ran for an invalid request")
+	}
+
+	if _, err := interceptor(context.Background(), &campaign.CreateCampaignRequest{Title: "Wells", TargetAmount: &campaign.Money{Units: 1}}, info, handler); err != nil {
+		t.Fatalf("valid request: %v", err)
+	}
+	if !handled {
+		t.Error("handler did not run for a valid request")
+	}
+}
@@ -0,0 +1,187 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Rule validates one aspect of a request message
+type Rule interface {
+	// bind resolves the field paths of the rule against the message it is added for
+	bind(desc protoreflect.MessageDescriptor) boundRule
+}
+
+type boundRule interface {
+	validate(msg protoreflect.Message) []*errdetails.BadRequest_FieldViolation
+}
+
+// RuleSet maps request messages to the rules they have to satisfy
+type RuleSet map[protoreflect.FullName][]boundRule
+
+// Add registers rules for the type of msg. It panics when a rule names a field msg does not
+// have, so mistakes show up when the rule set is declared rather than on the first request.
+func (s RuleSet) Add(msg proto.Message, rules ...Rule) RuleSet {
+	desc := msg.ProtoReflect().Descriptor()
+	for _, rule := range rules {
+		s[desc.FullName()] = append(s[desc.FullName()], rule.bind(desc))
+	}
+	return s
+}
+
+// Validate checks msg against its rules. It returns InvalidArgument with a google.rpc.BadRequest
+// listing every violated field, or nil when msg is valid or has no rules.
+func (s RuleSet) Validate(msg proto.Message) error {
+	reflected := msg.ProtoReflect()
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, rule := range s[reflected.Descriptor().FullName()] {
+		violations = append(violations, rule.validate(reflected)...)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	var descriptions []string
+	for _, val := range violations {
+		descriptions = append(descriptions, val.Field+" "+val.Description)
+	}
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(descriptions, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// fieldPath is a dot separated path of proto field names resolved against a message
+type fieldPath struct {
+	name   string
+	fields []protoreflect.FieldDescriptor
+}
+
+func resolvePath(desc protoreflect.MessageDescriptor, path string) fieldPath {
+	result := fieldPath{name: path}
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		field := desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			panic(fmt.Sprintf("validation: %v has no field %q", desc.FullName(), path))
+		}
+		result.fields = append(result.fields, field)
+		if i < len(names)-1 {
+			if field.Message() == nil || field.IsList() || field.IsMap() {
+				panic(fmt.Sprintf("validation: %q is not a path through messages of %v", path, desc.FullName()))
+			}
+			desc = field.Message()
+		}
+	}
+	return result
+}
+
+// lookup returns the value at the path and whether it is set. Messages and optional fields are set
+// when present, other scalars always count as set. ok is false when a message on the way is missing.
+func (p fieldPath) lookup(msg protoreflect.Message) (field protoreflect.FieldDescriptor, value protoreflect.Value, set bool, ok bool) {
+	for i, field := range p.fields {
+		if i == len(p.fields)-1 {
+			return field, msg.Get(field), !field.HasPresence() || msg.Has(field), true
+		}
+		if !msg.Has(field) {
+			return nil, protoreflect.Value{}, false, false
+		}
+		msg = msg.Get(field).Message()
+	}
+	return nil, protoreflect.Value{}, false, false
+}
+
+// Field applies checks to the field at path, e.g. "target_amount.units". Checks of a repeated
+// field run on every element. Nothing is checked when a message on the path is missing,
+// add a Required check on that message to reject it.
+func Field(path string, checks ...Check) Rule {
+	return fieldRule{path: path, checks: checks}
+}
+
+type fieldRule struct {
+	path   string
+	checks []Check
+}
+
+type boundFieldRule struct {
+	path   fieldPath
+	checks []Check
+}
+
+func (r fieldRule) bind(desc protoreflect.MessageDescriptor) boundRule {
+	return boundFieldRule{path: resolvePath(desc, r.path), checks: r.checks}
+}
+
+func (r boundFieldRule) validate(msg protoreflect.Message) []*errdetails.BadRequest_FieldViolation {
+	field, value, set, ok := r.path.lookup(msg)
+	if !ok {
+		return nil
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(name string, description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: name, Description: description})
+	}
+	if field.IsList() {
+		list := value.List()
+		for i := 0; i < list.Len(); i++ {
+			for _, check := range r.checks {
+				if description := check(field, list.Get(i), true); description != "" {
+					violate(fmt.Sprintf("%v[%d]", r.path.name, i), description)
+				}
+			}
+		}
+		return violations
+	}
+	for _, check := range r.checks {
+		if description := check(field, value, set); description != "" {
+			violate(r.path.name, description)
+			// Later checks usually build on earlier ones, like Required before Future
+			break
+		}
+	}
+	return violations
+}
+
+// LessOrEqual requires the number or timestamp at path a not to exceed the one at path b.
+// It only applies when both are set and reports the violation on a.
+func LessOrEqual(a string, b string) Rule {
+	return compareRule{a: a, b: b}
+}
+
+type compareRule struct {
+	a string
+	b string
+}
+
+type boundCompareRule struct {
+	a fieldPath
+	b fieldPath
+}
+
+func (r compareRule) bind(desc protoreflect.MessageDescriptor) boundRule {
+	return boundCompareRule{a: resolvePath(desc, r.a), b: resolvePath(desc, r.b)}
+}
+
+func (r boundCompareRule) validate(msg protoreflect.Message) []*errdetails.BadRequest_FieldViolation {
+	fieldA, valueA, setA, okA := r.a.lookup(msg)
+	fieldB, valueB, setB, okB := r.b.lookup(msg)
+	if !okA || !okB || !setA || !setB {
+		return nil
+	}
+	a, okA := orderedValue(fieldA, valueA)
+	b, okB := orderedValue(fieldB, valueB)
+	if !okA || !okB || a <= b {
+		return nil
+	}
+	return []*errdetails.BadRequest_FieldViolation{
+		{Field: r.a.name, Description: "must be less than or equal to " + r.b.name},
+	}
+}
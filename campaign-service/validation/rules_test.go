@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rayhanadri/crowdfunding-app-campaign-service/campaign-service/gen/go/campaign/v1"
+)
+
+// violations returns the fields and descriptions of the BadRequest attached to err
+func violations(t *testing.T, err error) []*errdetails.BadRequest_FieldViolation {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("error = %v, want InvalidArgument", err)
+	}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			return badRequest.FieldViolations
+		}
+	}
+	t.Fatalf("error %v has no BadRequest details", err)
+	return nil
+}
+
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	var fields []string
+	for _, val := range violations(t, err) {
+		fields = append(fields, val.Field)
+	}
+	return fields
+}
+
+func TestValidateBuildsBadRequest(t *testing.T) {
+	rules := RuleSet{}.Add(&campaign.CreateCampaignRequest{},
+		Field("title", NotBlank()),
+		Field("target_amount", Required()),
+		Field("deadline", Required(), Future()),
+	)
+
+	err := rules.Validate(&campaign.CreateCampaignRequest{Title: " "})
+	if want := "invalid request: title must not be blank; target_amount is required; deadline is required"; status.Convert(err).Message() != want {
+		t.Errorf("message = %q, want %q", status.Convert(err).Message(), want)
+	}
+	got := violations(t, err)
+	want := []*errdetails.BadRequest_FieldViolation{
+		{Field: "title", Description: "must not be blank"},
+		{Field: "target_amount", Description: "is required"},
+		{Field: "deadline", Description: "is required"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("violations = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("violation %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	valid := &campaign.CreateCampaignRequest{
+		Title:        "Wells",
+		TargetAmount: &campaign.Money{Units: 1},
+		Deadline:     timestamppb.New(time.Now().Add(time.Hour)),
+	}
+	if err := rules.Validate(valid); err != nil {
+		t.Errorf("Validate of a valid request: %v", err)
+	}
+	if err := rules.Validate(&campaign.GetCampaignByIDRequest{}); err != nil {
+		t.Errorf("Validate of a message without rules: %v", err)
+	}
+}
+
+func TestFieldRule(t *testing.T) {
+	rules := RuleSet{}.
+		Add(&campaign.CreateCampaignRequest{},
+			Field("target_amount.units", Positive()),
+			Field("deadline", Required(), Future()),
+		).
+		Add(&campaign.GetCampaignsByUserIDRequest{},
+			Field("statuses", Specified()),
+		)
+	future := timestamppb.New(time.Now().Add(time.Hour))
+
+	cases := []struct {
+		name string
+		msg  proto.Message
+		want []string
+	}{
+		{"nested field", &campaign.CreateCampaignRequest{TargetAmount: &campaign.Money{}, Deadline: future}, []string{"target_amount.units"}},
+		{"missing parent is skipped", &campaign.CreateCampaignRequest{Deadline: future}, nil},
+		// Only the first failing check of a field is reported
+		{"first failing check", &campaign.CreateCampaignRequest{TargetAmount: &campaign.Money{Units: 1}}, []string{"deadline"}},
+		{
+			name: "list elements",
+			msg:  &campaign.GetCampaignsByUserIDRequest{Statuses: []campaign.CampaignStatus{campaign.CampaignStatus_CAMPAIGN_STATUS_PAUSED, 0, 999}},
+			want: []string{"statuses[1]", "statuses[2]"},
+		},
+		{"empty list", &campaign.GetCampaignsByUserIDRequest{}, nil},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			err := rules.Validate(val.msg)
+			if val.want == nil {
+				if err != nil {
+					t.Errorf("Validate: %v", err)
+				}
+				return
+			}
+			if got := violatedFields(t, err); !slices.Equal(got, val.want) {
+				t.Errorf("fields = %v, want %v", got, val.want)
+			}
+		})
+	}
+}
+
+func TestLessOrEqual(t *testing.T) {
+	rules := RuleSet{}.Add(&campaign.ListCampaignsRequest{},
+		LessOrEqual("min_target_amount", "max_target_amount"),
+		LessOrEqual("deadline_from", "deadline_to"),
+	)
+	amount := func(value int64) *int64 { return &value }
+	now := time.Now()
+
+	cases := []struct {
+		name      string
+		msg       *campaign.ListCampaignsRequest
+		violation *errdetails.BadRequest_FieldViolation
+	}{
+		{name: "both unset", msg: &campaign.ListCampaignsRequest{}},
+		{name: "only lower bound", msg: &campaign.ListCampaignsRequest{MinTargetAmount: amount(10)}},
+		{name: "equal", msg: &campaign.ListCampaignsRequest{MinTargetAmount: amount(10), MaxTargetAmount: amount(10)}},
+		{
+			name:      "greater",
+			msg:       &campaign.ListCampaignsRequest{MinTargetAmount: amount(11), MaxTargetAmount: amount(10)},
+			violation: &errdetails.BadRequest_FieldViolation{Field: "min_target_amount", Description: "must be less than or equal to max_target_amount"},
+		},
+		{name: "timestamps in order", msg: &campaign.ListCampaignsRequest{DeadlineFrom: timestamppb.New(now), DeadlineTo: timestamppb.New(now.Add(time.Second))}},
+		{
+			name:      "timestamps reversed",
+			msg:       &campaign.ListCampaignsRequest{DeadlineFrom: timestamppb.New(now.Add(time.Second)), DeadlineTo: timestamppb.New(now)},
+			violation: &errdetails.BadRequest_FieldViolation{Field: "deadline_from", Description: "must be less than or equal to deadline_to"},
+		},
+	}
+	for _, val := range cases {
+		t.Run(val.name, func(t *testing.T) {
+			err := rules.Validate(val.msg)
+			if val.violation == nil {
+				if err != nil {
+					t.Errorf("Validate: %v", err)
+				}
+				return
+			}
+			if got := violations(t, err); len(got) != 1 || !proto.Equal(got[0], val.violation) {
+				t.Errorf("violations = %v, want %v", got, val.violation)
+			}
+		})
+	}
+}
+
+func TestAddRejectsUnknownPaths(t *testing.T) {
+	for _, rule := range []Rule{Field("name", NotBlank()), Field("title.length", NotBlank()), LessOrEqual("min_donation.units", "target")} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Add accepted %+v", rule)
+				}
+			}()
+			RuleSet{}.Add(&campaign.CreateCampaignRequest{}, rule)
+		}()
+	}
+}